@@ -0,0 +1,204 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// oauthStateCookie holds the server-generated CSRF state between
+// OAuthLogin's redirect and the provider's callback to OAuthCallback.
+const oauthStateCookie = "oauth_state"
+
+// oauthStateTTLSeconds bounds how long a user has to complete an OAuth
+// login before the state cookie expires.
+const oauthStateTTLSeconds = 5 * 60
+
+// AuthHandler exposes local and external-provider authentication endpoints
+type AuthHandler struct {
+	authSvc services.AuthService
+	logger  logger.Logger
+}
+
+// NewAuthHandler creates an AuthHandler
+func NewAuthHandler(authSvc services.AuthService, log logger.Logger) *AuthHandler {
+	return &AuthHandler{authSvc: authSvc, logger: log}
+}
+
+type loginRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// Login authenticates against the local username/password provider
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, user, err := h.authSvc.Login(c.Request.Context(), req.Username, req.Password)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token, "user": user})
+}
+
+type registerRequest struct {
+	Email     string `json:"email" binding:"required"`
+	Password  string `json:"password" binding:"required"`
+	FirstName string `json:"first_name" binding:"required"`
+	LastName  string `json:"last_name" binding:"required"`
+}
+
+// Register creates a new local user account
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req registerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user := &models.User{
+		Email:     req.Email,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+	}
+
+	created, err := h.authSvc.Register(c.Request.Context(), user, req.Password)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+type refreshRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RefreshToken issues a new JWT for a still-valid token
+func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	var req refreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, err := h.authSvc.RefreshToken(c.Request.Context(), req.Token)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
+
+// OAuthLogin redirects the caller to the named provider's consent screen.
+// The state passed to the provider is generated here, not taken from the
+// client, and stashed in a short-lived cookie so OAuthCallback can confirm
+// the callback belongs to a login this server actually started.
+func (h *AuthHandler) OAuthLogin(c *gin.Context) {
+	provider := c.Param("provider")
+
+	state, err := generateOAuthState()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to start oauth login"})
+		return
+	}
+
+	url, err := h.authSvc.AuthCodeURL(provider, state)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.SetCookie(oauthStateCookie, state, oauthStateTTLSeconds, "/", "", false, true)
+	c.Redirect(http.StatusTemporaryRedirect, url)
+}
+
+// OAuthCallback completes the named provider's authorization-code flow. It
+// rejects the callback outright if the returned state doesn't match the one
+// OAuthLogin stashed, which is the only thing standing between this
+// endpoint and CSRF/auth-code-fixation attacks delivered via a crafted
+// callback URL.
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	provider := c.Param("provider")
+	code := c.Query("code")
+	state := c.Query("state")
+
+	expectedState, cookieErr := c.Cookie(oauthStateCookie)
+	c.SetCookie(oauthStateCookie, "", -1, "/", "", false, true)
+	if cookieErr != nil || state == "" || state != expectedState {
+		h.logger.Error("oauth callback rejected: state mismatch", "provider", provider)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or missing oauth state"})
+		return
+	}
+
+	token, user, err := h.authSvc.HandleOAuthCallback(c.Request.Context(), provider, code)
+	if err != nil {
+		h.logger.Error("oauth callback failed", "provider", provider, "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token, "user": user})
+}
+
+// generateOAuthState returns a cryptographically random, URL-safe token
+// suitable as an OAuth2 state parameter.
+func generateOAuthState() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// ListIdentities returns the external identities linked to a user
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	identities, err := h.authSvc.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, identities)
+}
+
+// UnlinkIdentity removes one external identity from a user
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+	identityID, err := strconv.ParseUint(c.Param("identityId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid identity id"})
+		return
+	}
+
+	if err := h.authSvc.UnlinkIdentity(c.Request.Context(), userID, identityID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
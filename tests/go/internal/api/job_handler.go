@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// JobHandler exposes the scheduler's registered jobs to admins: listing
+// their schedule and run history, and triggering an off-schedule run.
+type JobHandler struct {
+	scheduler services.Scheduler
+	logger    logger.Logger
+}
+
+// NewJobHandler creates a JobHandler
+func NewJobHandler(scheduler services.Scheduler, log logger.Logger) *JobHandler {
+	return &JobHandler{scheduler: scheduler, logger: log}
+}
+
+// ListJobs returns every registered job's schedule, last scheduled run, and
+// next run.
+func (h *JobHandler) ListJobs(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"jobs": h.scheduler.Jobs()})
+}
+
+// RunJob triggers the named job immediately, outside its schedule.
+func (h *JobHandler) RunJob(c *gin.Context) {
+	name := c.Param("name")
+
+	if err := h.scheduler.RunNow(c.Request.Context(), name); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "completed"})
+}
@@ -0,0 +1,50 @@
+package router
+
+import (
+	"github.com/crawler/test-go/internal/api"
+	"github.com/crawler/test-go/internal/infrastructure/dependencies"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/pkg/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminRouter mounts the admin-only user management and job endpoints.
+type AdminRouter struct {
+	userHandler *api.UserHandler
+	authHandler *api.AuthHandler
+	jobHandler  *api.JobHandler
+	authSvc     services.AuthService
+}
+
+// NewAdminRouter creates an AdminRouter from the dependency container.
+func NewAdminRouter(d *dependencies.Container) *AdminRouter {
+	return &AdminRouter{
+		userHandler: api.NewUserHandler(d.UserSvc, d.UserImporter, d.Logger),
+		authHandler: api.NewAuthHandler(d.AuthSvc, d.Logger),
+		jobHandler:  api.NewJobHandler(d.Cron, d.Logger),
+		authSvc:     d.AuthSvc,
+	}
+}
+
+// Mount registers routes under /users and /admin, both gated behind
+// AuthMiddleware plus the "admin" role.
+func (ar *AdminRouter) Mount(r *gin.RouterGroup) {
+	users := r.Group("/users")
+	users.Use(middleware.AuthMiddleware(ar.authSvc))
+
+	admin := users.Group("")
+	admin.Use(middleware.RequireRole("admin"))
+
+	admin.GET("/admin/stats", ar.userHandler.GetUserStats)
+	admin.POST("/admin/bulk-update", ar.userHandler.BulkUpdateUsers)
+	admin.GET("/:id/identities", ar.authHandler.ListIdentities)
+	admin.DELETE("/:id/identities/:identityId", ar.authHandler.UnlinkIdentity)
+	admin.POST("/admin/import", ar.userHandler.ImportUsers)
+
+	jobs := r.Group("/admin")
+	jobs.Use(middleware.AuthMiddleware(ar.authSvc))
+	jobs.Use(middleware.RequireRole("admin"))
+
+	jobs.GET("/jobs", ar.jobHandler.ListJobs)
+	jobs.POST("/jobs/:name/run", ar.jobHandler.RunJob)
+}
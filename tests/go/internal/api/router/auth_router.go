@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/crawler/test-go/internal/api"
+	"github.com/crawler/test-go/internal/infrastructure/dependencies"
+	"github.com/gin-gonic/gin"
+)
+
+// AuthRouter mounts the public authentication endpoints.
+type AuthRouter struct {
+	handler *api.AuthHandler
+}
+
+// NewAuthRouter creates an AuthRouter from the dependency container.
+func NewAuthRouter(d *dependencies.Container) *AuthRouter {
+	return &AuthRouter{handler: api.NewAuthHandler(d.AuthSvc, d.Logger)}
+}
+
+// Mount registers local login/registration and OAuth routes under /auth.
+func (ar *AuthRouter) Mount(r *gin.RouterGroup) {
+	auth := r.Group("/auth")
+	auth.POST("/login", ar.handler.Login)
+	auth.POST("/register", ar.handler.Register)
+	auth.POST("/refresh", ar.handler.RefreshToken)
+	auth.GET("/:provider/login", ar.handler.OAuthLogin)
+	auth.GET("/:provider/callback", ar.handler.OAuthCallback)
+}
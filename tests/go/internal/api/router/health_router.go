@@ -0,0 +1,66 @@
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/crawler/test-go/internal/config"
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/infrastructure/dependencies"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// HealthRouter mounts liveness and readiness probes.
+type HealthRouter struct {
+	cfg   *config.Config
+	db    database.Database
+	cache services.CacheService
+}
+
+// NewHealthRouter creates a HealthRouter from the dependency container.
+func NewHealthRouter(d *dependencies.Container) *HealthRouter {
+	return &HealthRouter{cfg: d.Config, db: d.DB, cache: d.Cache}
+}
+
+// Mount registers /health and /ready at the router root.
+func (hr *HealthRouter) Mount(r *gin.RouterGroup) {
+	r.GET("/health", hr.health)
+	r.GET("/ready", hr.ready)
+}
+
+// health returns basic liveness status
+func (hr *HealthRouter) health(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":    "healthy",
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+		"version":   hr.cfg.Version,
+	})
+}
+
+// ready checks if all dependencies are ready
+func (hr *HealthRouter) ready(c *gin.Context) {
+	checks := map[string]bool{
+		"database": hr.db.Ping() == nil,
+		"cache":    hr.cache.Ping() == nil,
+	}
+
+	allReady := true
+	for _, ok := range checks {
+		if !ok {
+			allReady = false
+			break
+		}
+	}
+
+	status := http.StatusOK
+	if !allReady {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, gin.H{
+		"ready":     allReady,
+		"checks":    checks,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/crawler/test-go/internal/infrastructure/dependencies"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsRouter mounts the Prometheus scrape endpoint.
+type MetricsRouter struct {
+	handler http.Handler
+}
+
+// NewMetricsRouter creates a MetricsRouter from the dependency container.
+func NewMetricsRouter(d *dependencies.Container) *MetricsRouter {
+	return &MetricsRouter{handler: d.Metrics}
+}
+
+// Mount registers /metrics at the router root.
+func (mr *MetricsRouter) Mount(r *gin.RouterGroup) {
+	r.GET("/metrics", gin.WrapH(mr.handler))
+}
@@ -0,0 +1,12 @@
+// Package router splits the HTTP API into composable route groups. Each
+// Router mounts its own endpoints onto a shared *gin.RouterGroup so main.go
+// can register them without knowing the handlers or middleware each group
+// needs.
+package router
+
+import "github.com/gin-gonic/gin"
+
+// Router mounts a group of related endpoints onto r.
+type Router interface {
+	Mount(r *gin.RouterGroup)
+}
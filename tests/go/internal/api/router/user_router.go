@@ -0,0 +1,34 @@
+package router
+
+import (
+	"github.com/crawler/test-go/internal/api"
+	"github.com/crawler/test-go/internal/infrastructure/dependencies"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/pkg/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// UserRouter mounts the authenticated user CRUD endpoints.
+type UserRouter struct {
+	handler *api.UserHandler
+	authSvc services.AuthService
+}
+
+// NewUserRouter creates a UserRouter from the dependency container.
+func NewUserRouter(d *dependencies.Container) *UserRouter {
+	return &UserRouter{
+		handler: api.NewUserHandler(d.UserSvc, d.UserImporter, d.Logger),
+		authSvc: d.AuthSvc,
+	}
+}
+
+// Mount registers the /users CRUD routes, gated behind AuthMiddleware.
+func (ur *UserRouter) Mount(r *gin.RouterGroup) {
+	users := r.Group("/users")
+	users.Use(middleware.AuthMiddleware(ur.authSvc))
+
+	users.GET("", ur.handler.GetUsers)
+	users.GET("/:id", ur.handler.GetUser)
+	users.PUT("/:id", ur.handler.UpdateUser)
+	users.DELETE("/:id", ur.handler.DeleteUser)
+}
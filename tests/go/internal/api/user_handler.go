@@ -0,0 +1,317 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/internal/services/users/commands"
+	"github.com/crawler/test-go/internal/services/users/queries"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportUploadSize caps the multipart upload accepted by ImportUsers.
+const maxImportUploadSize = 25 << 20 // 25MB
+
+// UserHandler exposes CRUD and admin operations over models.User, sending
+// every request through the user command/query bus.
+type UserHandler struct {
+	bus      *users.Bus
+	importer *services.UserImporter
+	logger   logger.Logger
+}
+
+// NewUserHandler creates a UserHandler
+func NewUserHandler(bus *users.Bus, importer *services.UserImporter, log logger.Logger) *UserHandler {
+	return &UserHandler{bus: bus, importer: importer, logger: log}
+}
+
+// GetUsers lists users, optionally filtered by role/status/search and
+// paginated either by cursor (?cursor=, the default going forward) or by
+// offset (?page=, kept for existing clients). It sets X-Total-Count and an
+// RFC 5988 Link header with rel="next"/"prev"/"first".
+func (h *UserHandler) GetUsers(c *gin.Context) {
+	filters := &models.UserFilters{
+		Role:    models.UserRole(c.Query("role")),
+		Status:  models.UserStatus(c.Query("status")),
+		Search:  c.Query("search"),
+		Cursor:  c.Query("cursor"),
+		SortBy:  c.Query("sort_by"),
+		SortDir: c.Query("sort_dir"),
+	}
+	if page, err := strconv.Atoi(c.Query("page")); err == nil {
+		filters.Page = page
+	}
+	if pageSize, err := strconv.Atoi(c.Query("page_size")); err == nil {
+		filters.PageSize = pageSize
+	}
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil {
+		filters.Limit = limit
+	}
+
+	result, err := users.Dispatch[queries.List, queries.ListResult](c.Request.Context(), h.bus, queries.List{Filters: filters})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	page := result.Page
+	c.Header("X-Total-Count", strconv.FormatInt(page.TotalCount, 10))
+	if link := buildUserPageLinks(c, filters, page); link != "" {
+		c.Header("Link", link)
+	}
+
+	c.JSON(http.StatusOK, page.Items)
+}
+
+// buildUserPageLinks renders the RFC 5988 Link header for a users page: the
+// current request URL with its pagination params substituted for the
+// cursor (or page number) of each adjacent page.
+func buildUserPageLinks(c *gin.Context, filters *models.UserFilters, page *models.PageResult[*models.User]) string {
+	var links []string
+
+	addLink := func(rel string, params url.Values) {
+		u := *c.Request.URL
+		u.RawQuery = params.Encode()
+		links = append(links, fmt.Sprintf(`<%s>; rel="%s"`, u.String(), rel))
+	}
+
+	base := c.Request.URL.Query()
+
+	first := cloneQuery(base)
+	first.Del("page")
+	first.Del("cursor")
+	addLink("first", first)
+
+	if filters.Page > 0 {
+		// Offset fallback: build prev/next from page +/- 1.
+		if filters.Page > 1 {
+			prev := cloneQuery(base)
+			prev.Set("page", strconv.Itoa(filters.Page-1))
+			addLink("prev", prev)
+		}
+		if int64(filters.Page*pageSizeOrDefault(filters)) < page.TotalCount {
+			next := cloneQuery(base)
+			next.Set("page", strconv.Itoa(filters.Page+1))
+			addLink("next", next)
+		}
+		return strings.Join(links, ", ")
+	}
+
+	if page.NextCursor != "" {
+		next := cloneQuery(base)
+		next.Set("cursor", page.NextCursor)
+		addLink("next", next)
+	}
+	if page.PrevCursor != "" {
+		prev := cloneQuery(base)
+		prev.Set("cursor", page.PrevCursor)
+		addLink("prev", prev)
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func cloneQuery(src url.Values) url.Values {
+	dst := make(url.Values, len(src))
+	for k, v := range src {
+		dst[k] = append([]string(nil), v...)
+	}
+	return dst
+}
+
+func pageSizeOrDefault(filters *models.UserFilters) int {
+	if filters.PageSize > 0 {
+		return filters.PageSize
+	}
+	return 20
+}
+
+// GetUser fetches a single user by ID
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	result, err := users.Dispatch[queries.GetByID, queries.GetByIDResult](c.Request.Context(), h.bus, queries.GetByID{ID: id})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if result.User == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.User)
+}
+
+// UpdateUser applies a partial update to a user
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	var updates models.UserUpdates
+	if err := c.ShouldBindJSON(&updates); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := users.Dispatch[commands.UpdateUser, commands.UpdateUserResult](c.Request.Context(), h.bus, commands.UpdateUser{ID: id, Updates: &updates})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.User)
+}
+
+// DeleteUser soft deletes a user
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	if _, err := users.Dispatch[commands.DeleteUser, commands.DeleteUserResult](c.Request.Context(), h.bus, commands.DeleteUser{ID: id}); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetUserStats returns aggregate user statistics (admin only)
+func (h *UserHandler) GetUserStats(c *gin.Context) {
+	result, err := users.Dispatch[queries.Stats, queries.StatsResult](c.Request.Context(), h.bus, queries.Stats{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Stats)
+}
+
+// BulkUpdateUsers applies updates to many users at once (admin only)
+func (h *UserHandler) BulkUpdateUsers(c *gin.Context) {
+	var updates []*models.BulkUserUpdate
+	if err := c.ShouldBindJSON(&updates); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := users.Dispatch[commands.BulkUpdateUsers, commands.BulkUpdateUsersResult](c.Request.Context(), h.bus, commands.BulkUpdateUsers{Updates: updates}); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// ImportUsers bulk-creates users from an uploaded JSON or CSV file (admin
+// only). When the client sends Accept: application/x-ndjson, progress is
+// streamed as one JSON object per row instead of waiting for the final
+// report.
+func (h *UserHandler) ImportUsers(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportUploadSize)
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	defer file.Close()
+
+	parser, err := importParserFor(header.Filename, header.Header.Get("Content-Type"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	opts := services.ImportOptions{
+		DryRun:       c.PostForm("dry_run") == "true",
+		SkipExisting: c.PostForm("skip_existing") == "true",
+		DefaultRole:  models.UserRole(c.PostForm("default_role")),
+	}
+
+	if strings.Contains(c.GetHeader("Accept"), "application/x-ndjson") {
+		h.streamImport(c, parser, file, opts)
+		return
+	}
+
+	report, err := h.importer.Import(c.Request.Context(), parser, file, opts, nil)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// streamImport flushes one NDJSON line per row as the import progresses,
+// followed by a final line carrying the completed ImportReport. Import
+// calls onProgress from importWorkerCount goroutines concurrently, so every
+// write onto the single response writer is serialized under writeMu —
+// otherwise interleaved Encode/Flush calls would corrupt the NDJSON stream.
+func (h *UserHandler) streamImport(c *gin.Context, parser services.Parser, file multipart.File, opts services.ImportOptions) {
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Writer.WriteHeader(http.StatusOK)
+
+	var writeMu sync.Mutex
+	encoder := json.NewEncoder(c.Writer)
+	onProgress := func(progress services.ImportProgress) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_ = encoder.Encode(progress)
+		c.Writer.Flush()
+	}
+
+	report, err := h.importer.Import(c.Request.Context(), parser, file, opts, onProgress)
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	if err != nil {
+		_ = encoder.Encode(gin.H{"status": "error", "message": err.Error()})
+		c.Writer.Flush()
+		return
+	}
+
+	_ = encoder.Encode(gin.H{"status": "complete", "report": report})
+	c.Writer.Flush()
+}
+
+// importParserFor picks a services.Parser by file extension, falling back
+// to the upload's Content-Type.
+func importParserFor(filename, contentType string) (services.Parser, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return services.JSONParser{}, nil
+	case ".csv":
+		return services.CSVParser{}, nil
+	}
+
+	switch {
+	case strings.Contains(contentType, "json"):
+		return services.JSONParser{}, nil
+	case strings.Contains(contentType, "csv"):
+		return services.CSVParser{}, nil
+	}
+
+	return nil, fmt.Errorf("unrecognized import format: %s", filename)
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config holds all runtime configuration for the application, sourced from
+// environment variables with sane defaults for local development.
+type Config struct {
+	Environment   string
+	Version       string
+	Port          int
+	LogLevel      string
+	DatabaseURL   string
+	RedisURL      string
+	JWTSecret     string
+	AuthProviders map[string]OAuthProviderConfig
+}
+
+// OAuthProviderConfig configures one external login provider (oidc, github, google).
+// Keyed in Config.AuthProviders by the provider name used in the route, e.g. "github".
+type OAuthProviderConfig struct {
+	Type         string // "oidc", "github", or "google"
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	AuthURL      string // OIDC only
+	TokenURL     string // OIDC only
+	UserInfoURL  string // OIDC only
+}
+
+// Load reads configuration from the environment
+func Load() (*Config, error) {
+	port, err := strconv.Atoi(getEnv("PORT", "8080"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Environment:   getEnv("ENVIRONMENT", "development"),
+		Version:       getEnv("VERSION", "dev"),
+		Port:          port,
+		LogLevel:      getEnv("LOG_LEVEL", "info"),
+		DatabaseURL:   getEnv("DATABASE_URL", "postgres://localhost:5432/crawler?sslmode=disable"),
+		RedisURL:      getEnv("REDIS_URL", "redis://localhost:6379/0"),
+		JWTSecret:     getEnv("JWT_SECRET", "change-me"),
+		AuthProviders: loadAuthProviders(),
+	}, nil
+}
+
+// loadAuthProviders builds the configured external login providers from
+// AUTH_PROVIDER_<NAME>_* environment variables. A provider is only included
+// if its CLIENT_ID is set.
+func loadAuthProviders() map[string]OAuthProviderConfig {
+	providers := map[string]OAuthProviderConfig{}
+
+	for _, name := range []string{"github", "google", "oidc"} {
+		prefix := "AUTH_PROVIDER_" + strings.ToUpper(name) + "_"
+		clientID := os.Getenv(prefix + "CLIENT_ID")
+		if clientID == "" {
+			continue
+		}
+
+		providers[name] = OAuthProviderConfig{
+			Type:         getEnv(prefix+"TYPE", name),
+			ClientID:     clientID,
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			AuthURL:      os.Getenv(prefix + "AUTH_URL"),
+			TokenURL:     os.Getenv(prefix + "TOKEN_URL"),
+			UserInfoURL:  os.Getenv(prefix + "USERINFO_URL"),
+		}
+	}
+
+	return providers
+}
+
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return fallback
+}
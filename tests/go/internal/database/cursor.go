@@ -0,0 +1,74 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+// allowedSortColumns whitelists the columns GetUsers may order and seek by,
+// so a cursor can never be used to inject an arbitrary ORDER BY clause.
+var allowedSortColumns = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+	"email":      true,
+}
+
+// userCursor is the decoded form of UserFilters.Cursor: the sort column
+// value and ID of the last (or first, for dir "prev") row of the page the
+// cursor was issued with, which the adjacent page seeks past, plus the
+// direction to seek in.
+type userCursor struct {
+	LastID        uint64 `json:"last_id"`
+	LastSortValue string `json:"last_sort_value"`
+	Dir           string `json:"dir"`
+}
+
+func encodeUserCursor(c userCursor) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(raw string) (userCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return userCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	var c userCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return userCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return c, nil
+}
+
+// sortValue renders the column sortBy identifies on user as the comparable
+// string a cursor carries between requests.
+func sortValue(user *models.User, sortBy string) string {
+	switch sortBy {
+	case "created_at":
+		return user.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case "updated_at":
+		return user.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case "email":
+		return user.Email
+	default:
+		return fmt.Sprintf("%d", user.ID)
+	}
+}
+
+func normalizeSort(sortBy, sortDir string) (string, string) {
+	if !allowedSortColumns[sortBy] {
+		sortBy = "id"
+	}
+	if sortDir != "asc" && sortDir != "desc" {
+		sortDir = "asc"
+	}
+	return sortBy, sortDir
+}
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+func TestEncodeDecodeUserCursor_RoundTrip(t *testing.T) {
+	original := userCursor{LastID: 42, LastSortValue: "2026-01-01T00:00:00Z", Dir: "next"}
+
+	encoded := encodeUserCursor(original)
+	if encoded == "" {
+		t.Fatal("encodeUserCursor returned an empty string")
+	}
+
+	decoded, err := decodeUserCursor(encoded)
+	if err != nil {
+		t.Fatalf("decodeUserCursor: %v", err)
+	}
+	if decoded != original {
+		t.Errorf("decodeUserCursor = %+v, want %+v", decoded, original)
+	}
+}
+
+func TestDecodeUserCursor_InvalidInput(t *testing.T) {
+	cases := []string{
+		"not-valid-base64url!!",
+		"", // empty decodes fine as base64 but fails JSON unmarshal
+	}
+
+	for _, raw := range cases {
+		if _, err := decodeUserCursor(raw); err == nil {
+			t.Errorf("decodeUserCursor(%q) = nil error, want an error", raw)
+		}
+	}
+}
+
+func TestNormalizeSort(t *testing.T) {
+	tests := []struct {
+		name        string
+		sortBy      string
+		sortDir     string
+		wantSortBy  string
+		wantSortDir string
+	}{
+		{"allowed column passes through", "email", "desc", "email", "desc"},
+		{"id is allowed", "id", "asc", "id", "asc"},
+		{"updated_at is allowed", "updated_at", "desc", "updated_at", "desc"},
+		{"unknown column falls back to id", "password_hash", "asc", "id", "asc"},
+		{"empty column falls back to id", "", "asc", "id", "asc"},
+		{"unknown direction falls back to asc", "email", "sideways", "email", "asc"},
+		{"empty direction falls back to asc", "email", "", "email", "asc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sortBy, sortDir := normalizeSort(tt.sortBy, tt.sortDir)
+			if sortBy != tt.wantSortBy || sortDir != tt.wantSortDir {
+				t.Errorf("normalizeSort(%q, %q) = (%q, %q), want (%q, %q)",
+					tt.sortBy, tt.sortDir, sortBy, sortDir, tt.wantSortBy, tt.wantSortDir)
+			}
+		})
+	}
+}
+
+func TestSortValue(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := time.Date(2026, 6, 7, 8, 9, 10, 0, time.UTC)
+	user := &models.User{ID: 7, Email: "user@example.com", CreatedAt: createdAt, UpdatedAt: updatedAt}
+
+	tests := []struct {
+		sortBy string
+		want   string
+	}{
+		{"created_at", createdAt.UTC().Format(time.RFC3339Nano)},
+		{"updated_at", updatedAt.UTC().Format(time.RFC3339Nano)},
+		{"email", "user@example.com"},
+		{"id", "7"},
+		{"unknown", "7"},
+	}
+
+	for _, tt := range tests {
+		if got := sortValue(user, tt.sortBy); got != tt.want {
+			t.Errorf("sortValue(user, %q) = %q, want %q", tt.sortBy, got, tt.want)
+		}
+	}
+}
+
+func TestFlipSortDir(t *testing.T) {
+	if got := flipSortDir("asc"); got != "desc" {
+		t.Errorf("flipSortDir(asc) = %q, want desc", got)
+	}
+	if got := flipSortDir("desc"); got != "asc" {
+		t.Errorf("flipSortDir(desc) = %q, want asc", got)
+	}
+}
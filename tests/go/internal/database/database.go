@@ -0,0 +1,346 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Database is the persistence interface consumed by the services layer
+type Database interface {
+	CreateUser(ctx context.Context, user *models.User) error
+	GetUserByID(ctx context.Context, id uint64) (*models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+	GetUsers(ctx context.Context, filters *models.UserFilters) (*models.PageResult[*models.User], error)
+	UpdateUser(ctx context.Context, user *models.User) error
+	DeleteUser(ctx context.Context, id uint64) error
+	GetUserStats(ctx context.Context) (*models.UserStats, error)
+	BulkUpdateUsers(ctx context.Context, updates []*models.BulkUserUpdate) ([]*models.BulkUpdateResult, error)
+	PurgeDeletedUsers(ctx context.Context, olderThan time.Time) (int64, error)
+
+	CreateUserIdentity(ctx context.Context, identity *models.UserIdentity) error
+	GetUserIdentityByProvider(ctx context.Context, provider models.AuthType, providerID string) (*models.UserIdentity, error)
+	ListUserIdentities(ctx context.Context, userID uint64) ([]*models.UserIdentity, error)
+	DeleteUserIdentity(ctx context.Context, userID, identityID uint64) error
+
+	CreateAuditLog(ctx context.Context, entry *models.AuditLog) error
+
+	Ping() error
+	Close() error
+}
+
+type gormDatabase struct {
+	db *gorm.DB
+}
+
+// New opens a Postgres connection pool via gorm and runs schema migrations
+func New(dsn string) (Database, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(&models.User{}, &models.UserIdentity{}, &models.AuditLog{}); err != nil {
+		return nil, err
+	}
+
+	return &gormDatabase{db: db}, nil
+}
+
+func (g *gormDatabase) CreateUser(ctx context.Context, user *models.User) error {
+	return g.db.WithContext(ctx).Create(user).Error
+}
+
+func (g *gormDatabase) GetUserByID(ctx context.Context, id uint64) (*models.User, error) {
+	var user models.User
+	if err := g.db.WithContext(ctx).Where("status != ?", models.StatusDeleted).First(&user, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (g *gormDatabase) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	var user models.User
+	if err := g.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// applyUserFilters narrows a fresh *models.User query by the non-pagination
+// fields of filters; called once for the total count and again (with a
+// fresh base query) for the page itself. Soft-deleted users are excluded
+// unless filters.Status explicitly asks for them.
+func applyUserFilters(query *gorm.DB, filters *models.UserFilters) *gorm.DB {
+	if filters.Role != "" {
+		query = query.Where("role = ?", filters.Role)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	} else {
+		query = query.Where("status != ?", models.StatusDeleted)
+	}
+	if filters.Search != "" {
+		query = query.Where("email ILIKE ? OR first_name ILIKE ? OR last_name ILIKE ?",
+			"%"+filters.Search+"%", "%"+filters.Search+"%", "%"+filters.Search+"%")
+	}
+	return query
+}
+
+func (g *gormDatabase) GetUsers(ctx context.Context, filters *models.UserFilters) (*models.PageResult[*models.User], error) {
+	var total int64
+	if err := applyUserFilters(g.db.WithContext(ctx).Model(&models.User{}), filters).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	// An explicit ?page= always wins over a cursor, so existing offset-style
+	// clients keep working unchanged.
+	if filters.Page > 0 {
+		return g.getUsersByOffset(ctx, filters, total)
+	}
+	return g.getUsersByCursor(ctx, filters, total)
+}
+
+func (g *gormDatabase) getUsersByOffset(ctx context.Context, filters *models.UserFilters, total int64) (*models.PageResult[*models.User], error) {
+	pageSize := filters.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	page := filters.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	query := applyUserFilters(g.db.WithContext(ctx).Model(&models.User{}), filters).
+		Order("id asc").Limit(pageSize).Offset((page - 1) * pageSize)
+
+	var users []*models.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return &models.PageResult[*models.User]{Items: users, TotalCount: total, Limit: pageSize}, nil
+}
+
+// getUsersByCursor implements keyset pagination: it seeks past the cursor's
+// (sort column, id) tuple instead of using OFFSET, so deep pages don't
+// degrade into a full table scan.
+func (g *gormDatabase) getUsersByCursor(ctx context.Context, filters *models.UserFilters, total int64) (*models.PageResult[*models.User], error) {
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = filters.PageSize
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	sortBy, sortDir := normalizeSort(filters.SortBy, filters.SortDir)
+
+	hasCursor := filters.Cursor != ""
+	var cursor userCursor
+	if hasCursor {
+		var err error
+		cursor, err = decodeUserCursor(filters.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// seekDir is the direction actually queried in: walking backwards
+	// ("prev") seeks in the opposite direction so the nearest rows to the
+	// cursor come back first, then the slice is reversed below to restore
+	// sortDir order.
+	seekDir := sortDir
+	if hasCursor && cursor.Dir == "prev" {
+		seekDir = flipSortDir(sortDir)
+	}
+
+	query := applyUserFilters(g.db.WithContext(ctx).Model(&models.User{}), filters)
+	if hasCursor {
+		op := ">"
+		if seekDir == "desc" {
+			op = "<"
+		}
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortBy, op), cursor.LastSortValue, cursor.LastID)
+	}
+	query = query.Order(fmt.Sprintf("%s %s, id %s", sortBy, seekDir, seekDir)).Limit(limit + 1)
+
+	var users []*models.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	hasMore := len(users) > limit
+	if hasMore {
+		users = users[:limit]
+	}
+	if hasCursor && cursor.Dir == "prev" {
+		for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+			users[i], users[j] = users[j], users[i]
+		}
+	}
+
+	result := &models.PageResult[*models.User]{Items: users, TotalCount: total, Limit: limit}
+	if len(users) > 0 {
+		first, last := users[0], users[len(users)-1]
+		if (seekDir == sortDir && hasMore) || (hasCursor && cursor.Dir == "prev") {
+			result.NextCursor = encodeUserCursor(userCursor{LastID: last.ID, LastSortValue: sortValue(last, sortBy), Dir: "next"})
+		}
+		if (seekDir != sortDir && hasMore) || (hasCursor && cursor.Dir != "prev") {
+			result.PrevCursor = encodeUserCursor(userCursor{LastID: first.ID, LastSortValue: sortValue(first, sortBy), Dir: "prev"})
+		}
+	}
+
+	return result, nil
+}
+
+func flipSortDir(dir string) string {
+	if dir == "asc" {
+		return "desc"
+	}
+	return "asc"
+}
+
+func (g *gormDatabase) UpdateUser(ctx context.Context, user *models.User) error {
+	return g.db.WithContext(ctx).Save(user).Error
+}
+
+// DeleteUser soft deletes a user: the row is kept (so PurgeDeletedUsers has
+// something to clean up later), but Status moves to StatusDeleted and
+// DeletedAt is stamped so GetUsers/GetUserByID stop surfacing it immediately.
+func (g *gormDatabase) DeleteUser(ctx context.Context, id uint64) error {
+	var user models.User
+	if err := g.db.WithContext(ctx).First(&user, id).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.Status = models.StatusDeleted
+	user.DeletedAt = &now
+	return g.db.WithContext(ctx).Save(&user).Error
+}
+
+func (g *gormDatabase) GetUserStats(ctx context.Context) (*models.UserStats, error) {
+	stats := &models.UserStats{}
+	// Each count starts from a fresh query so its Where clause doesn't
+	// accumulate onto the previous count's conditions.
+	db := func() *gorm.DB { return g.db.WithContext(ctx).Model(&models.User{}) }
+
+	if err := db().Count(&stats.TotalUsers).Error; err != nil {
+		return nil, err
+	}
+	if err := db().Where("status = ?", models.StatusActive).Count(&stats.ActiveUsers).Error; err != nil {
+		return nil, err
+	}
+	if err := db().Where("status = ?", models.StatusInactive).Count(&stats.InactiveUsers).Error; err != nil {
+		return nil, err
+	}
+	if err := db().Where("role = ?", models.RoleAdmin).Count(&stats.AdminUsers).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (g *gormDatabase) BulkUpdateUsers(ctx context.Context, updates []*models.BulkUserUpdate) ([]*models.BulkUpdateResult, error) {
+	results := make([]*models.BulkUpdateResult, 0, len(updates))
+
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, update := range updates {
+			var user models.User
+			if err := tx.First(&user, update.UserID).Error; err != nil {
+				return err
+			}
+			old := user
+
+			if update.Updates.Role != nil {
+				user.Role = *update.Updates.Role
+			}
+			if update.Updates.Status != nil {
+				user.Status = *update.Updates.Status
+			}
+			if err := tx.Save(&user).Error; err != nil {
+				return err
+			}
+
+			results = append(results, &models.BulkUpdateResult{Old: &old, New: &user})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// PurgeDeletedUsers permanently removes users marked StatusDeleted whose
+// DeletedAt is older than olderThan, returning the number of rows removed.
+func (g *gormDatabase) PurgeDeletedUsers(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := g.db.WithContext(ctx).
+		Where("status = ? AND deleted_at IS NOT NULL AND deleted_at < ?", models.StatusDeleted, olderThan).
+		Delete(&models.User{})
+	return result.RowsAffected, result.Error
+}
+
+func (g *gormDatabase) CreateUserIdentity(ctx context.Context, identity *models.UserIdentity) error {
+	return g.db.WithContext(ctx).Create(identity).Error
+}
+
+func (g *gormDatabase) GetUserIdentityByProvider(ctx context.Context, provider models.AuthType, providerID string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	err := g.db.WithContext(ctx).
+		Where("provider = ? AND provider_id = ?", provider, providerID).
+		First(&identity).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (g *gormDatabase) ListUserIdentities(ctx context.Context, userID uint64) ([]*models.UserIdentity, error) {
+	var identities []*models.UserIdentity
+	if err := g.db.WithContext(ctx).Where("user_id = ?", userID).Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+func (g *gormDatabase) DeleteUserIdentity(ctx context.Context, userID, identityID uint64) error {
+	return g.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&models.UserIdentity{}, identityID).Error
+}
+
+func (g *gormDatabase) CreateAuditLog(ctx context.Context, entry *models.AuditLog) error {
+	return g.db.WithContext(ctx).Create(entry).Error
+}
+
+func (g *gormDatabase) Ping() error {
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Ping()
+}
+
+func (g *gormDatabase) Close() error {
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
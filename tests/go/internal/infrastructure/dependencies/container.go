@@ -0,0 +1,154 @@
+// Package dependencies wires every long-lived service the HTTP API depends
+// on into a single Container, built once at startup and injected into each
+// router.
+package dependencies
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/crawler/test-go/internal/config"
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/services"
+	"github.com/crawler/test-go/internal/services/jobs"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// cacheFrontTTL bounds how long the in-process Ristretto layer may hold an
+// entry before it must be refreshed from Redis, so the fast layer never
+// drifts far from the shared one.
+const cacheFrontTTL = 30 * time.Second
+
+// Container owns every dependency the API routers need. NewContainer builds
+// it once; routers receive it by pointer instead of each wiring their own
+// services.
+type Container struct {
+	Config       *config.Config
+	Logger       logger.Logger
+	DB           database.Database
+	Cache        services.CacheService
+	UserSvc      *users.Bus
+	UserImporter *services.UserImporter
+	AuthSvc      services.AuthService
+	Cron         services.Scheduler
+	Metrics      http.Handler
+}
+
+// NewContainer loads configuration and constructs every service in the
+// Container.
+func NewContainer() (*Container, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	log := logger.New(cfg.LogLevel)
+	log.Info("Starting application initialization")
+
+	db, err := database.New(cfg.DatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	cache, err := newCache(cfg, log)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthProviders, err := services.BuildOAuthProviders(cfg.AuthProviders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure auth providers: %w", err)
+	}
+
+	userSvc := services.NewUserBus(db, cache, log)
+	userImporter := services.NewUserImporter(userSvc, log)
+	authSvc := services.NewAuthService(userSvc, db, cfg.JWTSecret, log, oauthProviders)
+
+	cron, err := newScheduler(db, cache, log)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Application initialized successfully")
+
+	return &Container{
+		Config:       cfg,
+		Logger:       log,
+		DB:           db,
+		Cache:        cache,
+		UserSvc:      userSvc,
+		UserImporter: userImporter,
+		AuthSvc:      authSvc,
+		Cron:         cron,
+		Metrics:      promhttp.Handler(),
+	}, nil
+}
+
+// newScheduler builds a Scheduler with every built-in maintenance job
+// registered, ready for the caller to Start once the application has
+// finished wiring up.
+func newScheduler(db database.Database, cache services.CacheService, log logger.Logger) (services.Scheduler, error) {
+	scheduler := services.NewScheduler(log)
+
+	builtinJobs := []services.Job{
+		jobs.NewPurgeDeletedUsersJob(db, log),
+		jobs.NewStatsSnapshotJob(db, cache, log),
+		jobs.NewCacheWarmerJob(db, cache, log),
+		jobs.NewEmailRevalidationJob(db, users.NewValidator(), log),
+	}
+	for _, job := range builtinJobs {
+		if err := scheduler.Register(job); err != nil {
+			return nil, fmt.Errorf("failed to register job %q: %w", job.Name(), err)
+		}
+	}
+
+	return scheduler, nil
+}
+
+// newCache builds the in-process Ristretto layer fronting Redis, both
+// instrumented with hit/miss metrics.
+func newCache(cfg *config.Config, log logger.Logger) (services.CacheService, error) {
+	ristrettoCache, err := services.NewRistrettoCache(log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize ristretto cache: %w", err)
+	}
+	redisCache := services.NewCacheService(cfg.RedisURL, log)
+
+	cache := services.NewChainCache(
+		cacheFrontTTL,
+		services.NewMetricsCache("ristretto", ristrettoCache),
+		services.NewMetricsCache("redis", redisCache),
+	)
+	if err := cache.Connect(); err != nil {
+		return nil, fmt.Errorf("failed to connect to cache: %w", err)
+	}
+	return cache, nil
+}
+
+// Close releases every resource the Container owns.
+func (c *Container) Close() error {
+	var firstErr error
+
+	if c.Cron != nil {
+		c.Cron.Stop()
+	}
+	if c.DB != nil {
+		if err := c.DB.Close(); err != nil {
+			c.Logger.Error("database close failed", "error", err)
+			firstErr = err
+		}
+	}
+	if c.Cache != nil {
+		if err := c.Cache.Close(); err != nil {
+			c.Logger.Error("cache close failed", "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
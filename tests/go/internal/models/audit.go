@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// AuditLog is an append-only record of a mutating user action, written by
+// the audit subscriber in response to domain events.
+type AuditLog struct {
+	ID        uint64    `json:"id" gorm:"primaryKey"`
+	UserID    uint64    `json:"user_id" gorm:"index"`
+	Action    string    `json:"action"`
+	Detail    string    `json:"detail"`
+	CreatedAt time.Time `json:"created_at"`
+}
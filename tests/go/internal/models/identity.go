@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// AuthType identifies how a user authenticates
+type AuthType string
+
+const (
+	AuthTypeLocal  AuthType = "local"
+	AuthTypeOIDC   AuthType = "oidc"
+	AuthTypeGitHub AuthType = "github"
+	AuthTypeGoogle AuthType = "google"
+)
+
+// UserIdentity links a User to an external identity provider account. A user
+// authenticated purely via password has no rows here; one row is created per
+// external provider the user has ever logged in with.
+type UserIdentity struct {
+	ID         uint64    `json:"id" gorm:"primaryKey"`
+	UserID     uint64    `json:"user_id" gorm:"index"`
+	Provider   AuthType  `json:"provider"`
+	ProviderID string    `json:"provider_id" gorm:"index"`
+	Email      string    `json:"email"`
+	CreatedAt  time.Time `json:"created_at"`
+}
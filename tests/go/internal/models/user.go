@@ -0,0 +1,94 @@
+package models
+
+import "time"
+
+// UserRole represents the role assigned to a user
+type UserRole string
+
+const (
+	RoleAdmin UserRole = "admin"
+	RoleUser  UserRole = "user"
+)
+
+// UserStatus represents the lifecycle status of a user account
+type UserStatus string
+
+const (
+	StatusActive   UserStatus = "active"
+	StatusInactive UserStatus = "inactive"
+	StatusDeleted  UserStatus = "deleted"
+)
+
+// User represents a registered user of the system
+type User struct {
+	ID           uint64     `json:"id" gorm:"primaryKey"`
+	Email        string     `json:"email" gorm:"uniqueIndex"`
+	PasswordHash string     `json:"-"`
+	FirstName    string     `json:"first_name"`
+	LastName     string     `json:"last_name"`
+	Role         UserRole   `json:"role"`
+	Status       UserStatus `json:"status"`
+	AuthType     AuthType   `json:"auth_type"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+// UserFilters narrows the result set returned by Database.GetUsers.
+// Cursor/Limit/SortBy/SortDir drive keyset pagination; Page/PageSize remain
+// as an offset-based fallback for clients that pass ?page= instead of a
+// cursor.
+type UserFilters struct {
+	Role     UserRole
+	Status   UserStatus
+	Search   string
+	Page     int
+	PageSize int
+
+	Cursor  string
+	Limit   int
+	SortBy  string
+	SortDir string
+}
+
+// PageResult is a page of items returned by keyset- or offset-paginated
+// queries, along with the total matching count and opaque cursors for the
+// adjacent pages.
+type PageResult[T any] struct {
+	Items      []T
+	TotalCount int64
+	NextCursor string
+	PrevCursor string
+	Limit      int
+}
+
+// UserUpdates carries the mutable fields of a user; nil fields are left unchanged
+type UserUpdates struct {
+	Email     *string
+	FirstName *string
+	LastName  *string
+	Role      *UserRole
+	Status    *UserStatus
+}
+
+// UserStats summarizes the user population for admin dashboards
+type UserStats struct {
+	TotalUsers    int64 `json:"total_users"`
+	ActiveUsers   int64 `json:"active_users"`
+	InactiveUsers int64 `json:"inactive_users"`
+	AdminUsers    int64 `json:"admin_users"`
+}
+
+// BulkUserUpdate is a single entry in a bulk update request
+type BulkUserUpdate struct {
+	UserID  uint64       `json:"user_id"`
+	Updates *UserUpdates `json:"updates"`
+}
+
+// BulkUpdateResult carries one row's pre- and post-update state out of
+// Database.BulkUpdateUsers, so callers can publish accurate domain events
+// instead of one keyed only by ID.
+type BulkUpdateResult struct {
+	Old *User
+	New *User
+}
@@ -0,0 +1,72 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/crawler/test-go/internal/models"
+	"golang.org/x/oauth2"
+	githubendpoint "golang.org/x/oauth2/github"
+)
+
+const githubUserAPI = "https://api.github.com/user"
+
+// GitHubProvider authenticates users via GitHub OAuth apps
+type GitHubProvider struct {
+	oauth2Cfg *oauth2.Config
+}
+
+// NewGitHubProvider creates an OAuthProvider backed by GitHub
+func NewGitHubProvider(clientID, clientSecret, redirectURL string) *GitHubProvider {
+	return &GitHubProvider{
+		oauth2Cfg: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint:     githubendpoint.Endpoint,
+		},
+	}
+}
+
+func (p *GitHubProvider) Name() models.AuthType {
+	return models.AuthTypeGitHub
+}
+
+func (p *GitHubProvider) AuthCodeURL(state string) string {
+	return p.oauth2Cfg.AuthCodeURL(state)
+}
+
+func (p *GitHubProvider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	return p.oauth2Cfg.Exchange(ctx, code)
+}
+
+func (p *GitHubProvider) FetchUserInfo(ctx context.Context, token *oauth2.Token) (*ProviderUserInfo, error) {
+	client := p.oauth2Cfg.Client(ctx, token)
+	resp, err := client.Get(githubUserAPI)
+	if err != nil {
+		return nil, fmt.Errorf("fetching github user: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github user endpoint returned %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		ID    int64  `json:"id"`
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decoding github user: %w", err)
+	}
+
+	return &ProviderUserInfo{
+		ProviderID: fmt.Sprintf("%d", payload.ID),
+		Email:      payload.Email,
+		FirstName:  payload.Name,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/crawler/test-go/internal/models"
+	"golang.org/x/oauth2"
+	googleendpoint "golang.org/x/oauth2/google"
+)
+
+const googleUserInfoAPI = "https://www.googleapis.com/oauth2/v3/userinfo"
+
+// GoogleProvider authenticates users via Google OAuth 2.0
+type GoogleProvider struct {
+	oauth2Cfg *oauth2.Config
+}
+
+// NewGoogleProvider creates an OAuthProvider backed by Google
+func NewGoogleProvider(clientID, clientSecret, redirectURL string) *GoogleProvider {
+	return &GoogleProvider{
+		oauth2Cfg: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"openid", "profile", "email"},
+			Endpoint:     googleendpoint.Endpoint,
+		},
+	}
+}
+
+func (p *GoogleProvider) Name() models.AuthType {
+	return models.AuthTypeGoogle
+}
+
+func (p *GoogleProvider) AuthCodeURL(state string) string {
+	return p.oauth2Cfg.AuthCodeURL(state)
+}
+
+func (p *GoogleProvider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	return p.oauth2Cfg.Exchange(ctx, code)
+}
+
+func (p *GoogleProvider) FetchUserInfo(ctx context.Context, token *oauth2.Token) (*ProviderUserInfo, error) {
+	client := p.oauth2Cfg.Client(ctx, token)
+	resp, err := client.Get(googleUserInfoAPI)
+	if err != nil {
+		return nil, fmt.Errorf("fetching google userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google userinfo endpoint returned %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		Sub        string `json:"sub"`
+		Email      string `json:"email"`
+		GivenName  string `json:"given_name"`
+		FamilyName string `json:"family_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("decoding google userinfo: %w", err)
+	}
+
+	return &ProviderUserInfo{
+		ProviderID: payload.Sub,
+		Email:      payload.Email,
+		FirstName:  payload.GivenName,
+		LastName:   payload.FamilyName,
+	}, nil
+}
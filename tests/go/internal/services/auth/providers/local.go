@@ -0,0 +1,45 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crawler/test-go/internal/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserLookup is the minimal user lookup the local provider needs; declared
+// here rather than importing services to avoid an import cycle.
+type UserLookup interface {
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
+}
+
+// LocalProvider authenticates against the password hash stored on models.User.
+type LocalProvider struct {
+	users UserLookup
+}
+
+// NewLocalProvider creates the built-in username/password LoginProvider
+func NewLocalProvider(users UserLookup) *LocalProvider {
+	return &LocalProvider{users: users}
+}
+
+func (p *LocalProvider) Name() models.AuthType {
+	return models.AuthTypeLocal
+}
+
+func (p *LocalProvider) AttemptLogin(ctx context.Context, username, password string) (*models.User, error) {
+	user, err := p.users.GetUserByEmail(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("local login failed: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,36 @@
+// Package providers defines the pluggable login and OAuth/OIDC provider
+// interfaces consumed by services.AuthService, plus the built-in local,
+// OIDC, GitHub and Google implementations.
+package providers
+
+import (
+	"context"
+
+	"github.com/crawler/test-go/internal/models"
+	"golang.org/x/oauth2"
+)
+
+// LoginProvider authenticates a user against credentials it owns, e.g. the
+// local username/password store.
+type LoginProvider interface {
+	Name() models.AuthType
+	AttemptLogin(ctx context.Context, username, password string) (*models.User, error)
+}
+
+// ProviderUserInfo is the normalized identity returned by an OAuthProvider
+// once a token has been exchanged.
+type ProviderUserInfo struct {
+	ProviderID string
+	Email      string
+	FirstName  string
+	LastName   string
+}
+
+// OAuthProvider implements an external authorization-code flow (OIDC,
+// GitHub, Google, ...).
+type OAuthProvider interface {
+	Name() models.AuthType
+	AuthCodeURL(state string) string
+	Exchange(ctx context.Context, code string) (*oauth2.Token, error)
+	FetchUserInfo(ctx context.Context, token *oauth2.Token) (*ProviderUserInfo, error)
+}
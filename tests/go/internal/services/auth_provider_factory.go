@@ -0,0 +1,35 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/crawler/test-go/internal/config"
+	"github.com/crawler/test-go/internal/services/auth/providers"
+)
+
+// BuildOAuthProviders constructs the configured OAuthProviders keyed by route name
+func BuildOAuthProviders(cfgs map[string]config.OAuthProviderConfig) (map[string]providers.OAuthProvider, error) {
+	built := make(map[string]providers.OAuthProvider, len(cfgs))
+
+	for name, cfg := range cfgs {
+		switch cfg.Type {
+		case "github":
+			built[name] = providers.NewGitHubProvider(cfg.ClientID, cfg.ClientSecret, cfg.RedirectURL)
+		case "google":
+			built[name] = providers.NewGoogleProvider(cfg.ClientID, cfg.ClientSecret, cfg.RedirectURL)
+		case "oidc":
+			built[name] = providers.NewOIDCProvider(providers.OIDCConfig{
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				RedirectURL:  cfg.RedirectURL,
+				AuthURL:      cfg.AuthURL,
+				TokenURL:     cfg.TokenURL,
+				UserInfoURL:  cfg.UserInfoURL,
+			})
+		default:
+			return nil, fmt.Errorf("unknown auth provider type %q for provider %q", cfg.Type, name)
+		}
+	}
+
+	return built, nil
+}
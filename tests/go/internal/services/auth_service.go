@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services/auth/providers"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/internal/services/users/commands"
+	"github.com/crawler/test-go/internal/services/users/queries"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the custom fields carried inside issued JWTs
+type Claims struct {
+	UserID uint64 `json:"user_id"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// AuthService issues and validates JWTs on top of one local LoginProvider
+// and zero or more external OAuthProviders.
+type AuthService interface {
+	Login(ctx context.Context, username, password string) (string, *models.User, error)
+	Register(ctx context.Context, user *models.User, password string) (*models.User, error)
+	RefreshToken(ctx context.Context, token string) (string, error)
+	ValidateToken(token string) (*Claims, error)
+
+	AuthCodeURL(provider, state string) (string, error)
+	HandleOAuthCallback(ctx context.Context, provider, code string) (string, *models.User, error)
+
+	ListIdentities(ctx context.Context, userID uint64) ([]*models.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID, identityID uint64) error
+}
+
+type authService struct {
+	bus       *users.Bus
+	db        database.Database
+	jwtSecret string
+	logger    logger.Logger
+	local     providers.LoginProvider
+	oauth     map[string]providers.OAuthProvider
+}
+
+// NewAuthService creates the default AuthService, wiring the built-in local
+// provider plus the external providers in oauthProviders. User reads/writes go
+// through bus rather than a concrete UserService.
+func NewAuthService(bus *users.Bus, db database.Database, jwtSecret string, log logger.Logger, oauthProviders map[string]providers.OAuthProvider) AuthService {
+	return &authService{
+		bus:       bus,
+		db:        db,
+		jwtSecret: jwtSecret,
+		logger:    log,
+		local:     providers.NewLocalProvider(&busUserLookup{bus: bus}),
+		oauth:     oauthProviders,
+	}
+}
+
+// busUserLookup adapts the users.Bus to providers.UserLookup so LocalProvider
+// doesn't need to know commands/queries exist.
+type busUserLookup struct {
+	bus *users.Bus
+}
+
+func (b *busUserLookup) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	result, err := users.Dispatch[queries.GetByEmail, queries.GetByEmailResult](ctx, b.bus, queries.GetByEmail{Email: email})
+	if err != nil {
+		return nil, err
+	}
+	return result.User, nil
+}
+
+func (s *authService) Login(ctx context.Context, username, password string) (string, *models.User, error) {
+	user, err := s.local.AttemptLogin(ctx, username, password)
+	if err != nil {
+		return "", nil, fmt.Errorf("login failed: %w", err)
+	}
+
+	token, err := s.issueToken(user)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, user, nil
+}
+
+func (s *authService) Register(ctx context.Context, user *models.User, password string) (*models.User, error) {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user.PasswordHash = hash
+	user.AuthType = models.AuthTypeLocal
+	user.Status = models.StatusActive
+	if user.Role == "" {
+		user.Role = models.RoleUser
+	}
+
+	result, err := users.Dispatch[commands.CreateUser, commands.CreateUserResult](ctx, s.bus, commands.CreateUser{User: user})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.User, nil
+}
+
+func (s *authService) RefreshToken(ctx context.Context, tokenStr string) (string, error) {
+	claims, err := s.ValidateToken(tokenStr)
+	if err != nil {
+		return "", fmt.Errorf("cannot refresh invalid token: %w", err)
+	}
+
+	result, err := users.Dispatch[queries.GetByID, queries.GetByIDResult](ctx, s.bus, queries.GetByID{ID: claims.UserID})
+	if err != nil || result.User == nil {
+		return "", fmt.Errorf("user no longer exists")
+	}
+
+	return s.issueToken(result.User)
+}
+
+func (s *authService) ValidateToken(tokenStr string) (*Claims, error) {
+	claims := &Claims{}
+
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(s.jwtSecret), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	return claims, nil
+}
+
+// AuthCodeURL returns the redirect URL for the named external provider
+func (s *authService) AuthCodeURL(provider, state string) (string, error) {
+	p, ok := s.oauth[provider]
+	if !ok {
+		return "", fmt.Errorf("unknown auth provider: %s", provider)
+	}
+	return p.AuthCodeURL(state), nil
+}
+
+// HandleOAuthCallback exchanges the authorization code, auto-provisioning a
+// local user on first login, and returns a JWT as if the user had logged in
+// with a password.
+func (s *authService) HandleOAuthCallback(ctx context.Context, provider, code string) (string, *models.User, error) {
+	p, ok := s.oauth[provider]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown auth provider: %s", provider)
+	}
+
+	token, err := p.Exchange(ctx, code)
+	if err != nil {
+		return "", nil, fmt.Errorf("oauth exchange failed: %w", err)
+	}
+
+	info, err := p.FetchUserInfo(ctx, token)
+	if err != nil {
+		return "", nil, fmt.Errorf("fetching user info failed: %w", err)
+	}
+
+	user, err := s.findOrProvisionUser(ctx, p.Name(), info)
+	if err != nil {
+		return "", nil, err
+	}
+
+	jwtToken, err := s.issueToken(user)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return jwtToken, user, nil
+}
+
+func (s *authService) findOrProvisionUser(ctx context.Context, provider models.AuthType, info *providers.ProviderUserInfo) (*models.User, error) {
+	identity, err := s.db.GetUserIdentityByProvider(ctx, provider, info.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("looking up identity: %w", err)
+	}
+	if identity != nil {
+		byID, err := users.Dispatch[queries.GetByID, queries.GetByIDResult](ctx, s.bus, queries.GetByID{ID: identity.UserID})
+		if err != nil {
+			return nil, err
+		}
+		return byID.User, nil
+	}
+
+	byEmail, err := users.Dispatch[queries.GetByEmail, queries.GetByEmailResult](ctx, s.bus, queries.GetByEmail{Email: info.Email})
+	if err != nil {
+		return nil, fmt.Errorf("looking up user by email: %w", err)
+	}
+
+	user := byEmail.User
+	if user == nil {
+		created, err := users.Dispatch[commands.CreateUser, commands.CreateUserResult](ctx, s.bus, commands.CreateUser{User: &models.User{
+			Email:     info.Email,
+			FirstName: info.FirstName,
+			LastName:  info.LastName,
+			Role:      models.RoleUser,
+			Status:    models.StatusActive,
+			AuthType:  provider,
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("provisioning user from %s: %w", provider, err)
+		}
+		user = created.User
+	}
+
+	if err := s.db.CreateUserIdentity(ctx, &models.UserIdentity{
+		UserID:     user.ID,
+		Provider:   provider,
+		ProviderID: info.ProviderID,
+		Email:      info.Email,
+		CreatedAt:  time.Now(),
+	}); err != nil {
+		return nil, fmt.Errorf("saving identity: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *authService) ListIdentities(ctx context.Context, userID uint64) ([]*models.UserIdentity, error) {
+	return s.db.ListUserIdentities(ctx, userID)
+}
+
+func (s *authService) UnlinkIdentity(ctx context.Context, userID, identityID uint64) error {
+	return s.db.DeleteUserIdentity(ctx, userID, identityID)
+}
+
+func (s *authService) issueToken(user *models.User) (string, error) {
+	claims := &Claims{
+		UserID: user.ID,
+		Role:   string(user.Role),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(s.jwtSecret))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signed, nil
+}
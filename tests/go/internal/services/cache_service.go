@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+// CacheService is a generic key-value cache abstraction. NewCacheService
+// returns the Redis-backed implementation; NewChainCache composes several
+// layers (e.g. an in-process NewRistrettoCache fronting it) behind the same
+// interface.
+type CacheService interface {
+	Get(ctx context.Context, key string, dest interface{}) error
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+
+	// GetOrLoad reads key into dest, or on a miss calls loader, caches its
+	// result under key for ttl, and copies it into dest.
+	GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error
+
+	Connect() error
+	Ping() error
+	Close() error
+}
+
+// simpleGetOrLoad is the non-deduplicating GetOrLoad shared by single-layer
+// caches; it goes straight to loader on every concurrent miss. The cache
+// returned by NewChainCache has its own GetOrLoad, which uses singleflight so
+// concurrent misses coalesce into one load.
+func simpleGetOrLoad(ctx context.Context, cache CacheService, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	if err := cache.Get(ctx, key, dest); err == nil {
+		return nil
+	}
+
+	loaded, err := loader()
+	if err != nil {
+		return err
+	}
+
+	// A failed Set is not an error for the caller. The loaded value is copied
+	// directly rather than re-read: a layer such as Ristretto may drop or
+	// defer the Set, so a Get here could still miss.
+	_ = cache.Set(ctx, key, loaded, ttl)
+	return copyViaJSON(loaded, dest)
+}
+
+func copyViaJSON(src, dest interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+type redisCacheService struct {
+	client *redis.Client
+	logger logger.Logger
+}
+
+// NewCacheService creates a Redis-backed CacheService
+func NewCacheService(redisURL string, log logger.Logger) CacheService {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		// Fall back to a default client; Connect will surface the real error.
+		opts = &redis.Options{Addr: redisURL}
+	}
+
+	return &redisCacheService{
+		client: redis.NewClient(opts),
+		logger: log,
+	}
+}
+
+func (c *redisCacheService) Connect() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return c.client.Ping(ctx).Err()
+}
+
+func (c *redisCacheService) Get(ctx context.Context, key string, dest interface{}) error {
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c *redisCacheService) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, key, data, ttl).Err()
+}
+
+func (c *redisCacheService) Delete(ctx context.Context, key string) error {
+	return c.client.Del(ctx, key).Err()
+}
+
+func (c *redisCacheService) GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	return simpleGetOrLoad(ctx, c, key, dest, ttl, loader)
+}
+
+func (c *redisCacheService) Ping() error {
+	return c.client.Ping(context.Background()).Err()
+}
+
+func (c *redisCacheService) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type chainCache struct {
+	layers   []CacheService
+	frontTTL time.Duration
+	group    singleflight.Group
+}
+
+// NewChainCache composes layers into a single CacheService that reads
+// top-down (layers[0] first) and writes through: a hit in a lower layer is
+// backfilled into every layer above it, capped at frontTTL so a fast
+// in-process layer never outlives the data it fronts. Set and Delete apply
+// to every layer.
+func NewChainCache(frontTTL time.Duration, layers ...CacheService) CacheService {
+	return &chainCache{layers: layers, frontTTL: frontTTL}
+}
+
+func (c *chainCache) Get(ctx context.Context, key string, dest interface{}) error {
+	for i, layer := range c.layers {
+		if err := layer.Get(ctx, key, dest); err == nil {
+			c.backfill(ctx, key, dest, i)
+			return nil
+		}
+	}
+	return fmt.Errorf("cache miss: %s", key)
+}
+
+// backfill writes dest into every layer before index foundAt, so the next
+// read of key is served by the fastest layer.
+func (c *chainCache) backfill(ctx context.Context, key string, dest interface{}, foundAt int) {
+	for i := 0; i < foundAt; i++ {
+		_ = c.layers[i].Set(ctx, key, dest, c.frontTTL)
+	}
+}
+
+// Set writes value to every layer, capping the TTL of every layer but the
+// last at frontTTL: the last layer is the authoritative shared cache and
+// gets the caller's ttl verbatim, while every faster layer in front of it
+// must never hold the value longer than frontTTL, matching backfill.
+func (c *chainCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	var firstErr error
+	for i, layer := range c.layers {
+		layerTTL := ttl
+		if i < len(c.layers)-1 && c.frontTTL < layerTTL {
+			layerTTL = c.frontTTL
+		}
+		if err := layer.Set(ctx, key, value, layerTTL); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (c *chainCache) Delete(ctx context.Context, key string) error {
+	var firstErr error
+	for _, layer := range c.layers {
+		if err := layer.Delete(ctx, key); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// GetOrLoad reads key through the chain, and on a total miss uses
+// singleflight so concurrent callers for the same cold key coalesce into a
+// single loader call instead of each hitting the database.
+func (c *chainCache) GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	if err := c.Get(ctx, key, dest); err == nil {
+		return nil
+	}
+
+	loaded, err, _ := c.group.Do(key, func() (interface{}, error) {
+		return loader()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.Set(ctx, key, loaded, ttl); err != nil {
+		return copyViaJSON(loaded, dest)
+	}
+
+	return c.Get(ctx, key, dest)
+}
+
+func (c *chainCache) Connect() error {
+	for _, layer := range c.layers {
+		if err := layer.Connect(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainCache) Ping() error {
+	for _, layer := range c.layers {
+		if err := layer.Ping(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *chainCache) Close() error {
+	var firstErr error
+	for _, layer := range c.layers {
+		if err := layer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeCacheService is a minimal in-memory CacheService used to observe what
+// chainCache writes to each layer, without needing Redis or Ristretto.
+type fakeCacheService struct {
+	mu      sync.Mutex
+	data    map[string][]byte
+	lastTTL map[string]time.Duration
+}
+
+func newFakeCacheService() *fakeCacheService {
+	return &fakeCacheService{data: map[string][]byte{}, lastTTL: map[string]time.Duration{}}
+}
+
+func (f *fakeCacheService) Get(ctx context.Context, key string, dest interface{}) error {
+	f.mu.Lock()
+	data, ok := f.data[key]
+	f.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("cache miss: %s", key)
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (f *fakeCacheService) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	f.data[key] = data
+	f.lastTTL[key] = ttl
+	f.mu.Unlock()
+	return nil
+}
+
+func (f *fakeCacheService) Delete(ctx context.Context, key string) error {
+	f.mu.Lock()
+	delete(f.data, key)
+	delete(f.lastTTL, key)
+	f.mu.Unlock()
+	return nil
+}
+
+func (f *fakeCacheService) GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	return simpleGetOrLoad(ctx, f, key, dest, ttl, loader)
+}
+
+func (f *fakeCacheService) Connect() error { return nil }
+func (f *fakeCacheService) Ping() error    { return nil }
+func (f *fakeCacheService) Close() error   { return nil }
+
+func (f *fakeCacheService) ttlFor(key string) (time.Duration, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ttl, ok := f.lastTTL[key]
+	return ttl, ok
+}
+
+type cachedRecord struct {
+	Name string
+}
+
+func TestChainCache_SetCapsEveryLayerButTheLastAtFrontTTL(t *testing.T) {
+	front := newFakeCacheService()
+	back := newFakeCacheService()
+	cache := NewChainCache(30*time.Second, front, back)
+
+	if err := cache.Set(context.Background(), "k", cachedRecord{Name: "a"}, 5*time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if got, _ := front.ttlFor("k"); got != 30*time.Second {
+		t.Errorf("front layer ttl = %v, want %v", got, 30*time.Second)
+	}
+	if got, _ := back.ttlFor("k"); got != 5*time.Minute {
+		t.Errorf("back (last) layer ttl = %v, want %v", got, 5*time.Minute)
+	}
+}
+
+func TestChainCache_SetLeavesShortTTLUnchanged(t *testing.T) {
+	front := newFakeCacheService()
+	back := newFakeCacheService()
+	cache := NewChainCache(30*time.Second, front, back)
+
+	if err := cache.Set(context.Background(), "k", cachedRecord{Name: "a"}, 5*time.Second); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if got, _ := front.ttlFor("k"); got != 5*time.Second {
+		t.Errorf("front layer ttl = %v, want %v (shorter than frontTTL, should pass through)", got, 5*time.Second)
+	}
+}
+
+func TestChainCache_GetOrLoadCapsFrontLayerTTLOnColdKey(t *testing.T) {
+	front := newFakeCacheService()
+	back := newFakeCacheService()
+	cache := NewChainCache(30*time.Second, front, back)
+
+	var dest cachedRecord
+	err := cache.GetOrLoad(context.Background(), "k", &dest, 5*time.Minute, func() (interface{}, error) {
+		return cachedRecord{Name: "a"}, nil
+	})
+	if err != nil {
+		t.Fatalf("GetOrLoad: %v", err)
+	}
+	if dest.Name != "a" {
+		t.Fatalf("dest.Name = %q, want %q", dest.Name, "a")
+	}
+
+	if got, _ := front.ttlFor("k"); got != 30*time.Second {
+		t.Errorf("front layer ttl after cold GetOrLoad = %v, want %v", got, 30*time.Second)
+	}
+}
+
+func TestChainCache_GetBackfillsUpperLayersCappedAtFrontTTL(t *testing.T) {
+	front := newFakeCacheService()
+	back := newFakeCacheService()
+	cache := NewChainCache(30*time.Second, front, back)
+
+	if err := back.Set(context.Background(), "k", cachedRecord{Name: "a"}, time.Hour); err != nil {
+		t.Fatalf("seeding back layer: %v", err)
+	}
+
+	var dest cachedRecord
+	if err := cache.Get(context.Background(), "k", &dest); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if dest.Name != "a" {
+		t.Fatalf("dest.Name = %q, want %q", dest.Name, "a")
+	}
+
+	if _, ok := front.ttlFor("k"); !ok {
+		t.Fatal("expected Get to backfill the front layer")
+	}
+	if got, _ := front.ttlFor("k"); got != 30*time.Second {
+		t.Errorf("backfilled front layer ttl = %v, want %v", got, 30*time.Second)
+	}
+}
+
+func TestChainCache_GetOrLoadCoalescesConcurrentColdLoads(t *testing.T) {
+	front := newFakeCacheService()
+	back := newFakeCacheService()
+	cache := NewChainCache(30*time.Second, front, back)
+
+	var loadCount int32
+	loader := func() (interface{}, error) {
+		atomic.AddInt32(&loadCount, 1)
+		time.Sleep(10 * time.Millisecond)
+		return cachedRecord{Name: "a"}, nil
+	}
+
+	const callers = 20
+	var wg sync.WaitGroup
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			var dest cachedRecord
+			if err := cache.GetOrLoad(context.Background(), "k", &dest, time.Minute, loader); err != nil {
+				t.Errorf("GetOrLoad: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&loadCount); got != 1 {
+		t.Errorf("loader called %d times, want 1", got)
+	}
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+// ImportRow is one parsed user record, independent of whether it came from
+// JSON or CSV.
+type ImportRow struct {
+	Email     string
+	FirstName string
+	LastName  string
+	Role      models.UserRole
+}
+
+// Parser turns an uploaded file into ImportRows. New formats plug in by
+// implementing this interface, not by branching inside UserImporter.
+type Parser interface {
+	Parse(r io.Reader) ([]ImportRow, error)
+}
+
+// JSONParser reads a JSON array of user objects, e.g.
+// [{"email": "a@example.com", "first_name": "A", "last_name": "B", "role": "user"}].
+type JSONParser struct{}
+
+func (JSONParser) Parse(r io.Reader) ([]ImportRow, error) {
+	var raw []struct {
+		Email     string `json:"email"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Role      string `json:"role"`
+	}
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON import: %w", err)
+	}
+
+	rows := make([]ImportRow, len(raw))
+	for i, rr := range raw {
+		rows[i] = ImportRow{
+			Email:     rr.Email,
+			FirstName: rr.FirstName,
+			LastName:  rr.LastName,
+			Role:      models.UserRole(rr.Role),
+		}
+	}
+	return rows, nil
+}
+
+// CSVParser reads a CSV file with a header row naming the columns
+// email, first_name, last_name, role (order and casing don't matter, and
+// role may be omitted).
+type CSVParser struct{}
+
+func (CSVParser) Parse(r io.Reader) ([]ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV import: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	col := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	field := func(record []string, name string) string {
+		idx, ok := col[name]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	rows := make([]ImportRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		rows = append(rows, ImportRow{
+			Email:     field(record, "email"),
+			FirstName: field(record, "first_name"),
+			LastName:  field(record, "last_name"),
+			Role:      models.UserRole(field(record, "role")),
+		})
+	}
+	return rows, nil
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// revalidateBatchSize bounds how many users EmailRevalidationJob loads per
+// page while walking the users table.
+const revalidateBatchSize = 200
+
+// EmailRevalidationJob walks every user that is not soft-deleted (the
+// default GetUsers filter skips StatusDeleted) and flags malformed email addresses
+// into the audit log, catching records written before a stricter validator
+// was in place, or mutated outside the API.
+type EmailRevalidationJob struct {
+	db        database.Database
+	validator users.Validator
+	logger    logger.Logger
+}
+
+// NewEmailRevalidationJob creates an EmailRevalidationJob.
+func NewEmailRevalidationJob(db database.Database, validator users.Validator, log logger.Logger) *EmailRevalidationJob {
+	return &EmailRevalidationJob{db: db, validator: validator, logger: log}
+}
+
+func (j *EmailRevalidationJob) Name() string { return "email-revalidation" }
+
+// Schedule runs daily at 04:00 UTC.
+func (j *EmailRevalidationJob) Schedule() string { return "0 4 * * *" }
+
+func (j *EmailRevalidationJob) Run(ctx context.Context) error {
+	var cursor string
+	var scanned, flagged int
+
+	for {
+		page, err := j.db.GetUsers(ctx, &models.UserFilters{
+			Limit:   revalidateBatchSize,
+			Cursor:  cursor,
+			SortBy:  "id",
+			SortDir: "asc",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list users to revalidate: %w", err)
+		}
+
+		for _, user := range page.Items {
+			scanned++
+			if err := j.validator.ValidateEmail(user.Email); err != nil {
+				flagged++
+				entry := &models.AuditLog{
+					UserID: user.ID,
+					Action: "email_revalidation_anomaly",
+					Detail: err.Error(),
+				}
+				if err := j.db.CreateAuditLog(ctx, entry); err != nil {
+					j.logger.Warn("failed to write email revalidation audit log", "user_id", user.ID, "error", err)
+				}
+			}
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	j.logger.Info("completed email revalidation sweep", "scanned", scanned, "flagged", flagged)
+	return nil
+}
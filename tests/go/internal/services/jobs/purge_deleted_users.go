@@ -0,0 +1,46 @@
+// Package jobs implements the Scheduler's built-in user maintenance jobs.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// purgeRetention is how long a soft-deleted user is kept before
+// PurgeDeletedUsersJob removes it permanently.
+const purgeRetention = 30 * 24 * time.Hour
+
+// PurgeDeletedUsersJob hard-deletes users that have been soft-deleted for
+// longer than purgeRetention. This only finds rows once DeleteUser has
+// actually set Status to StatusDeleted and stamped DeletedAt; if DeleteUser
+// ever goes back to a hard delete, this job silently purges nothing.
+type PurgeDeletedUsersJob struct {
+	db     database.Database
+	logger logger.Logger
+}
+
+// NewPurgeDeletedUsersJob creates a PurgeDeletedUsersJob.
+func NewPurgeDeletedUsersJob(db database.Database, log logger.Logger) *PurgeDeletedUsersJob {
+	return &PurgeDeletedUsersJob{db: db, logger: log}
+}
+
+func (j *PurgeDeletedUsersJob) Name() string { return "purge-deleted-users" }
+
+// Schedule runs nightly at 02:00 UTC.
+func (j *PurgeDeletedUsersJob) Schedule() string { return "0 2 * * *" }
+
+func (j *PurgeDeletedUsersJob) Run(ctx context.Context) error {
+	cutoff := time.Now().UTC().Add(-purgeRetention)
+
+	purged, err := j.db.PurgeDeletedUsers(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to purge deleted users: %w", err)
+	}
+
+	j.logger.Info("purged soft-deleted users", "count", purged, "older_than", cutoff)
+	return nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	cacheHitsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_hits_total",
+		Help: "Number of cache reads that found a value, by layer.",
+	}, []string{"layer"})
+
+	cacheMissesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_misses_total",
+		Help: "Number of cache reads that found nothing, by layer.",
+	}, []string{"layer"})
+)
+
+type metricsCache struct {
+	layer string
+	next  CacheService
+}
+
+// NewMetricsCache wraps next so every Get is counted as a hit or miss under
+// cache_hits_total{layer}/cache_misses_total{layer}. layer should identify
+// the wrapped cache, e.g. "ristretto" or "redis".
+func NewMetricsCache(layer string, next CacheService) CacheService {
+	return &metricsCache{layer: layer, next: next}
+}
+
+func (c *metricsCache) Get(ctx context.Context, key string, dest interface{}) error {
+	err := c.next.Get(ctx, key, dest)
+	if err == nil {
+		cacheHitsTotal.WithLabelValues(c.layer).Inc()
+	} else {
+		cacheMissesTotal.WithLabelValues(c.layer).Inc()
+	}
+	return err
+}
+
+func (c *metricsCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	return c.next.Set(ctx, key, value, ttl)
+}
+
+func (c *metricsCache) Delete(ctx context.Context, key string) error {
+	return c.next.Delete(ctx, key)
+}
+
+func (c *metricsCache) GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	return c.next.GetOrLoad(ctx, key, dest, ttl, loader)
+}
+
+func (c *metricsCache) Connect() error { return c.next.Connect() }
+func (c *metricsCache) Ping() error    { return c.next.Ping() }
+func (c *metricsCache) Close() error   { return c.next.Close() }
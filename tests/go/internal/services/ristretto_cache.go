@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/dgraph-io/ristretto"
+)
+
+type ristrettoCacheService struct {
+	client *ristretto.Cache
+	logger logger.Logger
+}
+
+// NewRistrettoCache creates an in-process CacheService suitable as the front
+// layer of a NewChainCache, fronting a slower shared cache such as Redis.
+func NewRistrettoCache(log logger.Logger) (CacheService, error) {
+	client, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e7,     // track ~10x the entries we expect to hold
+		MaxCost:     1 << 27, // ~128MB of cost budget
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ristretto cache: %w", err)
+	}
+
+	return &ristrettoCacheService{client: client, logger: log}, nil
+}
+
+func (c *ristrettoCacheService) Get(ctx context.Context, key string, dest interface{}) error {
+	data, ok := c.client.Get(key)
+	if !ok {
+		return fmt.Errorf("cache miss: %s", key)
+	}
+	return json.Unmarshal(data.([]byte), dest)
+}
+
+func (c *ristrettoCacheService) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if !c.client.SetWithTTL(key, data, int64(len(data)), ttl) {
+		c.logger.Debug("ristretto dropped set due to internal contention", "key", key)
+	}
+	return nil
+}
+
+func (c *ristrettoCacheService) Delete(ctx context.Context, key string) error {
+	c.client.Del(key)
+	return nil
+}
+
+func (c *ristrettoCacheService) GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error {
+	return simpleGetOrLoad(ctx, c, key, dest, ttl, loader)
+}
+
+func (c *ristrettoCacheService) Connect() error { return nil }
+func (c *ristrettoCacheService) Ping() error    { return nil }
+
+func (c *ristrettoCacheService) Close() error {
+	c.client.Close()
+	return nil
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/go-co-op/gocron"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Job is a unit of scheduled work: a name used for logging, metrics and the
+// admin jobs listing, a cron schedule, and the work itself.
+type Job interface {
+	Name() string
+	Schedule() string
+	Run(ctx context.Context) error
+}
+
+// JobStatus summarizes a registered Job's schedule and run history, as
+// returned by the admin jobs endpoint. LastRun and NextRun come from the
+// cron schedule; a run triggered through RunNow is not reflected in LastRun.
+type JobStatus struct {
+	Name     string    `json:"name"`
+	Schedule string    `json:"schedule"`
+	LastRun  time.Time `json:"last_run"`
+	NextRun  time.Time `json:"next_run"`
+}
+
+// Scheduler registers Jobs and runs each on its own cron schedule, while
+// letting callers list their status or trigger one on demand.
+type Scheduler interface {
+	Register(job Job) error
+	Start()
+	Stop()
+	Jobs() []JobStatus
+	RunNow(ctx context.Context, name string) error
+}
+
+var (
+	jobRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cron_job_runs_total",
+		Help: "Number of scheduled job runs, by job name and outcome.",
+	}, []string{"job", "outcome"})
+
+	jobDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "cron_job_duration_seconds",
+		Help: "Duration of scheduled job runs in seconds, by job name.",
+	}, []string{"job"})
+)
+
+type gocronScheduler struct {
+	cron *gocron.Scheduler
+
+	mu         sync.RWMutex
+	jobs       map[string]Job
+	gocronJobs map[string]*gocron.Job
+
+	logger logger.Logger
+}
+
+// NewScheduler creates a Scheduler backed by go-co-op/gocron, evaluating
+// every job's cron schedule in UTC.
+func NewScheduler(log logger.Logger) Scheduler {
+	return &gocronScheduler{
+		cron:       gocron.NewScheduler(time.UTC),
+		jobs:       make(map[string]Job),
+		gocronJobs: make(map[string]*gocron.Job),
+		logger:     log,
+	}
+}
+
+// Register schedules job to run on its cron Schedule. Registering the same
+// Name twice is an error.
+func (s *gocronScheduler) Register(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[job.Name()]; exists {
+		return fmt.Errorf("job %q already registered", job.Name())
+	}
+
+	gj, err := s.cron.Cron(job.Schedule()).Do(func() {
+		_ = s.execute(context.Background(), job)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule job %q: %w", job.Name(), err)
+	}
+
+	s.jobs[job.Name()] = job
+	s.gocronJobs[job.Name()] = gj
+	return nil
+}
+
+// Start begins running registered jobs on their schedules in the
+// background.
+func (s *gocronScheduler) Start() {
+	s.cron.StartAsync()
+}
+
+// Stop halts the scheduler; an in-flight job run is allowed to finish.
+func (s *gocronScheduler) Stop() {
+	s.cron.Stop()
+}
+
+// Jobs lists every registered job's schedule and run history, sorted by
+// name.
+func (s *gocronScheduler) Jobs() []JobStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for name, job := range s.jobs {
+		gj := s.gocronJobs[name]
+		statuses = append(statuses, JobStatus{
+			Name:     name,
+			Schedule: job.Schedule(),
+			LastRun:  gj.LastRun(),
+			NextRun:  gj.NextRun(),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// RunNow executes the named job immediately, outside its schedule, and
+// returns its error.
+func (s *gocronScheduler) RunNow(ctx context.Context, name string) error {
+	s.mu.RLock()
+	job, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("no job registered with name %q", name)
+	}
+	return s.execute(ctx, job)
+}
+
+// execute runs job, recording its outcome as a structured log line and
+// Prometheus metrics.
+func (s *gocronScheduler) execute(ctx context.Context, job Job) error {
+	start := time.Now()
+	err := job.Run(ctx)
+	duration := time.Since(start)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+		s.logger.Error("scheduled job failed", "job", job.Name(), "duration", duration, "error", err)
+	} else {
+		s.logger.Info("scheduled job completed", "job", job.Name(), "duration", duration)
+	}
+
+	jobRunsTotal.WithLabelValues(job.Name(), outcome).Inc()
+	jobDurationSeconds.WithLabelValues(job.Name()).Observe(duration.Seconds())
+	return err
+}
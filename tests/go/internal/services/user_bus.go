@@ -0,0 +1,31 @@
+package services
+
+import (
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/internal/services/users/commands"
+	"github.com/crawler/test-go/internal/services/users/queries"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// NewUserBus wires the user command/query handlers and the cache
+// invalidation and audit log subscribers onto a fresh users.Bus.
+func NewUserBus(db database.Database, cache CacheService, log logger.Logger) *users.Bus {
+	bus := users.NewBus(log, users.LoggingMiddleware(log), users.ValidationMiddleware(), users.TracingMiddleware())
+	validator := users.NewValidator()
+
+	users.Register[commands.CreateUser, commands.CreateUserResult](bus, commands.NewCreateUserHandler(db, validator, bus, log))
+	users.Register[commands.UpdateUser, commands.UpdateUserResult](bus, commands.NewUpdateUserHandler(db, validator, bus, log))
+	users.Register[commands.DeleteUser, commands.DeleteUserResult](bus, commands.NewDeleteUserHandler(db, bus, log))
+	users.Register[commands.BulkUpdateUsers, commands.BulkUpdateUsersResult](bus, commands.NewBulkUpdateUsersHandler(db, bus, log))
+
+	users.Register[queries.GetByID, queries.GetByIDResult](bus, queries.NewGetByIDHandler(db, cache, log))
+	users.Register[queries.GetByEmail, queries.GetByEmailResult](bus, queries.NewGetByEmailHandler(db, cache, log))
+	users.Register[queries.List, queries.ListResult](bus, queries.NewListHandler(db, cache))
+	users.Register[queries.Stats, queries.StatsResult](bus, queries.NewStatsHandler(db, cache))
+
+	users.RegisterCacheInvalidationSubscriber(bus, cache, log)
+	users.RegisterAuditLogSubscriber(bus, db, log)
+
+	return bus
+}
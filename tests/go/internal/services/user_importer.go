@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/internal/services/users/commands"
+	"github.com/crawler/test-go/internal/services/users/queries"
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/hashicorp/go-multierror"
+)
+
+// importWorkerCount bounds how many rows UserImporter.Import dispatches to
+// the user bus concurrently.
+const importWorkerCount = 8
+
+// ImportOptions controls how UserImporter.Import treats each parsed row.
+type ImportOptions struct {
+	DryRun       bool
+	SkipExisting bool
+	DefaultRole  models.UserRole
+}
+
+// ImportRowError reports why a single row could not be imported.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Email   string `json:"email"`
+	Message string `json:"message"`
+}
+
+// ImportReport summarizes a completed import.
+type ImportReport struct {
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+// ImportProgress describes the outcome of a single row; UserImporter.Import
+// reports one of these per row to onProgress as it completes, so a caller
+// can stream progress instead of waiting for the final ImportReport.
+type ImportProgress struct {
+	Row     int    `json:"row"`
+	Email   string `json:"email"`
+	Status  string `json:"status"` // "imported", "skipped", or "error"
+	Message string `json:"message,omitempty"`
+}
+
+// UserImporter streams parsed rows through the user command bus using a
+// worker pool, so one slow or failing row never serializes the rest of the
+// batch. A row's validation or duplicate error never aborts the batch; it is
+// only recorded in the returned ImportReport.
+type UserImporter struct {
+	bus    *users.Bus
+	logger logger.Logger
+}
+
+// NewUserImporter creates a UserImporter
+func NewUserImporter(bus *users.Bus, log logger.Logger) *UserImporter {
+	return &UserImporter{bus: bus, logger: log}
+}
+
+// Import parses r with parser and creates each row as a user. onProgress,
+// if non-nil, is invoked once per row (from whichever worker goroutine
+// processed it) so the caller can stream progress live.
+func (imp *UserImporter) Import(ctx context.Context, parser Parser, r io.Reader, opts ImportOptions, onProgress func(ImportProgress)) (*ImportReport, error) {
+	rows, err := parser.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{}
+	var mu sync.Mutex
+	var errs *multierror.Error
+
+	record := func(progress ImportProgress, rowErr error) {
+		mu.Lock()
+		switch progress.Status {
+		case "imported":
+			report.Imported++
+		case "skipped":
+			report.Skipped++
+		case "error":
+			report.Errors = append(report.Errors, ImportRowError{Row: progress.Row, Email: progress.Email, Message: progress.Message})
+			errs = multierror.Append(errs, rowErr)
+		}
+		mu.Unlock()
+
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	type indexedRow struct {
+		idx int
+		row ImportRow
+	}
+	jobs := make(chan indexedRow)
+
+	workers := importWorkerCount
+	if workers > len(rows) {
+		workers = len(rows)
+	}
+	if workers == 0 {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				imp.importRow(ctx, job.idx+1, job.row, opts, record)
+			}
+		}()
+	}
+
+	for i, row := range rows {
+		jobs <- indexedRow{idx: i, row: row}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if errs != nil {
+		imp.logger.Warn("user import completed with row errors", "error_count", len(errs.Errors))
+	}
+
+	return report, nil
+}
+
+func (imp *UserImporter) importRow(ctx context.Context, rowNum int, row ImportRow, opts ImportOptions, record func(ImportProgress, error)) {
+	fail := func(message string) {
+		record(ImportProgress{Row: rowNum, Email: row.Email, Status: "error", Message: message},
+			fmt.Errorf("row %d (%s): %s", rowNum, row.Email, message))
+	}
+
+	if row.Email == "" {
+		fail("email is required")
+		return
+	}
+
+	existing, err := users.Dispatch[queries.GetByEmail, queries.GetByEmailResult](ctx, imp.bus, queries.GetByEmail{Email: row.Email})
+	if err != nil {
+		fail(fmt.Sprintf("looking up existing user: %v", err))
+		return
+	}
+	if existing.User != nil {
+		if opts.SkipExisting {
+			record(ImportProgress{Row: rowNum, Email: row.Email, Status: "skipped", Message: "user already exists"}, nil)
+			return
+		}
+		fail("user already exists")
+		return
+	}
+
+	role := row.Role
+	if role == "" {
+		role = opts.DefaultRole
+	}
+	if role == "" {
+		role = models.RoleUser
+	}
+
+	if opts.DryRun {
+		record(ImportProgress{Row: rowNum, Email: row.Email, Status: "imported", Message: "dry run"}, nil)
+		return
+	}
+
+	user := &models.User{
+		Email:     row.Email,
+		FirstName: row.FirstName,
+		LastName:  row.LastName,
+		Role:      role,
+		Status:    models.StatusActive,
+		AuthType:  models.AuthTypeLocal,
+	}
+
+	if _, err := users.Dispatch[commands.CreateUser, commands.CreateUserResult](ctx, imp.bus, commands.CreateUser{User: user}); err != nil {
+		fail(err.Error())
+		return
+	}
+
+	record(ImportProgress{Row: rowNum, Email: row.Email, Status: "imported"}, nil)
+}
@@ -0,0 +1,119 @@
+// Package users implements a small CQRS mediator for user commands and
+// queries: a Bus dispatches messages to the handler registered for their
+// concrete type, running them through a middleware chain, and separately
+// fans domain events out to subscribers (cache invalidation, auditing, ...).
+package users
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// Handler processes a single command or query type and produces its result.
+type Handler[T any, R any] interface {
+	Handle(ctx context.Context, msg T) (R, error)
+}
+
+// HandlerFunc is the type-erased form a Handler is reduced to once registered,
+// so the Bus can dispatch by reflect.Type without knowing concrete types.
+type HandlerFunc func(ctx context.Context, msg any) (any, error)
+
+// Middleware wraps a HandlerFunc, e.g. for logging, validation or tracing.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// EventHandler reacts to a published domain event.
+type EventHandler func(ctx context.Context, event any) error
+
+// Bus dispatches commands/queries to their registered Handler and publishes
+// domain events to subscribers. It is safe for concurrent use.
+type Bus struct {
+	mu          sync.RWMutex
+	logger      logger.Logger
+	middleware  []Middleware
+	handlers    map[reflect.Type]HandlerFunc
+	subscribers map[reflect.Type][]EventHandler
+}
+
+// NewBus creates a Bus with the given middleware chain, applied in order
+// (the first middleware wraps all others).
+func NewBus(log logger.Logger, middleware ...Middleware) *Bus {
+	return &Bus{
+		logger:      log,
+		middleware:  middleware,
+		handlers:    make(map[reflect.Type]HandlerFunc),
+		subscribers: make(map[reflect.Type][]EventHandler),
+	}
+}
+
+// Register associates the concrete message type T with a Handler. Registering
+// the same type twice replaces the previous handler.
+func Register[T any, R any](b *Bus, handler Handler[T, R]) {
+	msgType := reflect.TypeOf((*T)(nil)).Elem()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[msgType] = func(ctx context.Context, msg any) (any, error) {
+		return handler.Handle(ctx, msg.(T))
+	}
+}
+
+// Dispatch sends msg to the Handler registered for its type, through the
+// Bus's middleware chain, and type-asserts the result back to R.
+func Dispatch[T any, R any](ctx context.Context, b *Bus, msg T) (R, error) {
+	var zero R
+
+	msgType := reflect.TypeOf(msg)
+	b.mu.RLock()
+	h, ok := b.handlers[msgType]
+	b.mu.RUnlock()
+	if !ok {
+		return zero, fmt.Errorf("no handler registered for %s", msgType)
+	}
+
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		h = b.middleware[i](h)
+	}
+
+	result, err := h(ctx, msg)
+	if err != nil {
+		return zero, err
+	}
+
+	r, ok := result.(R)
+	if !ok {
+		return zero, fmt.Errorf("handler for %s returned unexpected result type %T", msgType, result)
+	}
+	return r, nil
+}
+
+// Subscribe registers handler to run whenever an event of type E is published.
+func Subscribe[E any](b *Bus, handler func(ctx context.Context, event E) error) {
+	eventType := reflect.TypeOf((*E)(nil)).Elem()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[eventType] = append(b.subscribers[eventType], func(ctx context.Context, event any) error {
+		return handler(ctx, event.(E))
+	})
+}
+
+// Publish fans event out to every subscriber registered for its concrete
+// type. Subscriber errors are logged, not returned: a failing projection
+// must never roll back the command that produced the event.
+func (b *Bus) Publish(ctx context.Context, event any) {
+	eventType := reflect.TypeOf(event)
+
+	b.mu.RLock()
+	handlers := b.subscribers[eventType]
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			b.logger.Warn("event subscriber failed", "event", eventType.Name(), "error", err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package users
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+// IDCacheKey and EmailCacheKey are shared by the query handlers that
+// populate the cache and the subscribers that invalidate it, so the two
+// sides can never drift apart.
+
+func IDCacheKey(id uint64) string {
+	return fmt.Sprintf("user:id:%d", id)
+}
+
+func EmailCacheKey(email string) string {
+	return fmt.Sprintf("user:email:%s", email)
+}
+
+// StatsCacheKey identifies the cached aggregate UserStats snapshot that
+// StatsSnapshotJob refreshes and StatsHandler reads.
+func StatsCacheKey() string {
+	return "user:stats:snapshot"
+}
+
+// ListCacheKey identifies a single page of a user listing: every field of
+// filters that affects the result set (including the cursor) feeds the
+// hash, so distinct pages of the same filter never collide and an
+// unrelated filter combination never serves a stale page.
+func ListCacheKey(filters *models.UserFilters) string {
+	raw := fmt.Sprintf("%s|%s|%s|%d|%d|%s|%d|%s|%s",
+		filters.Role, filters.Status, filters.Search,
+		filters.Page, filters.PageSize,
+		filters.Cursor, filters.Limit, filters.SortBy, filters.SortDir)
+	sum := sha256.Sum256([]byte(raw))
+	return "user:list:" + hex.EncodeToString(sum[:])[:16]
+}
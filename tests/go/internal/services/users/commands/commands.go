@@ -0,0 +1,77 @@
+// Package commands holds the write-side messages of the user CQRS split,
+// each paired with the Result its Handler returns.
+package commands
+
+import (
+	"fmt"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+// CreateUser creates a brand new user.
+type CreateUser struct {
+	User *models.User
+}
+
+// CreateUserResult is returned by CreateUser's Handler.
+type CreateUserResult struct {
+	User *models.User
+}
+
+func (c CreateUser) Validate() error {
+	if c.User == nil {
+		return fmt.Errorf("user is required")
+	}
+	return nil
+}
+
+// UpdateUser applies a partial update to an existing user.
+type UpdateUser struct {
+	ID      uint64
+	Updates *models.UserUpdates
+}
+
+// UpdateUserResult is returned by UpdateUser's Handler.
+type UpdateUserResult struct {
+	User *models.User
+}
+
+func (c UpdateUser) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("id is required")
+	}
+	if c.Updates == nil {
+		return fmt.Errorf("updates are required")
+	}
+	return nil
+}
+
+// DeleteUser soft deletes a user.
+type DeleteUser struct {
+	ID uint64
+}
+
+// DeleteUserResult is returned by DeleteUser's Handler.
+type DeleteUserResult struct{}
+
+func (c DeleteUser) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("id is required")
+	}
+	return nil
+}
+
+// BulkUpdateUsers applies many updates in one transaction.
+type BulkUpdateUsers struct {
+	Updates []*models.BulkUserUpdate
+}
+
+// BulkUpdateUsersResult is returned by BulkUpdateUsers's Handler.
+type BulkUpdateUsersResult struct{}
+
+func (c BulkUpdateUsers) Validate() error {
+	if len(c.Updates) == 0 {
+		return fmt.Errorf("at least one update is required")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// CreateUserHandler persists a new user and publishes users.UserCreated.
+type CreateUserHandler struct {
+	db        database.Database
+	validator users.Validator
+	bus       *users.Bus
+	logger    logger.Logger
+}
+
+func NewCreateUserHandler(db database.Database, validator users.Validator, bus *users.Bus, log logger.Logger) *CreateUserHandler {
+	return &CreateUserHandler{db: db, validator: validator, bus: bus, logger: log}
+}
+
+func (h *CreateUserHandler) Handle(ctx context.Context, cmd CreateUser) (CreateUserResult, error) {
+	user := cmd.User
+
+	if errs := h.validator.ValidateUserData(user); len(errs) > 0 {
+		return CreateUserResult{}, fmt.Errorf("user validation failed: %v", errs)
+	}
+
+	existing, err := h.db.GetUserByEmail(ctx, user.Email)
+	if err != nil {
+		return CreateUserResult{}, fmt.Errorf("checking for existing user: %w", err)
+	}
+	if existing != nil {
+		return CreateUserResult{}, fmt.Errorf("user with email %s already exists", user.Email)
+	}
+
+	now := time.Now()
+	user.CreatedAt = now
+	user.UpdatedAt = now
+	if user.Status == "" {
+		user.Status = models.StatusActive
+	}
+
+	if err := h.db.CreateUser(ctx, user); err != nil {
+		return CreateUserResult{}, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	h.logger.Info("user created", "user_id", user.ID, "email", user.Email)
+	h.bus.Publish(ctx, users.UserCreated{User: user})
+
+	return CreateUserResult{User: user}, nil
+}
+
+// UpdateUserHandler applies a partial update and publishes users.UserUpdated.
+type UpdateUserHandler struct {
+	db        database.Database
+	validator users.Validator
+	bus       *users.Bus
+	logger    logger.Logger
+}
+
+func NewUpdateUserHandler(db database.Database, validator users.Validator, bus *users.Bus, log logger.Logger) *UpdateUserHandler {
+	return &UpdateUserHandler{db: db, validator: validator, bus: bus, logger: log}
+}
+
+func (h *UpdateUserHandler) Handle(ctx context.Context, cmd UpdateUser) (UpdateUserResult, error) {
+	existing, err := h.db.GetUserByID(ctx, cmd.ID)
+	if err != nil {
+		return UpdateUserResult{}, fmt.Errorf("failed to load user: %w", err)
+	}
+	if existing == nil {
+		return UpdateUserResult{}, fmt.Errorf("user with ID %d not found", cmd.ID)
+	}
+
+	updated := applyUpdates(existing, cmd.Updates)
+	if errs := h.validator.ValidateUserData(updated); len(errs) > 0 {
+		return UpdateUserResult{}, fmt.Errorf("user validation failed: %v", errs)
+	}
+
+	updated.UpdatedAt = time.Now()
+	if err := h.db.UpdateUser(ctx, updated); err != nil {
+		return UpdateUserResult{}, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	h.logger.Info("user updated", "user_id", updated.ID)
+	h.bus.Publish(ctx, users.UserUpdated{Old: existing, New: updated})
+
+	return UpdateUserResult{User: updated}, nil
+}
+
+func applyUpdates(user *models.User, updates *models.UserUpdates) *models.User {
+	updated := *user
+
+	if updates.Email != nil {
+		updated.Email = *updates.Email
+	}
+	if updates.FirstName != nil {
+		updated.FirstName = *updates.FirstName
+	}
+	if updates.LastName != nil {
+		updated.LastName = *updates.LastName
+	}
+	if updates.Role != nil {
+		updated.Role = *updates.Role
+	}
+	if updates.Status != nil {
+		updated.Status = *updates.Status
+	}
+
+	return &updated
+}
+
+// DeleteUserHandler soft deletes a user and publishes users.UserDeleted.
+type DeleteUserHandler struct {
+	db     database.Database
+	bus    *users.Bus
+	logger logger.Logger
+}
+
+func NewDeleteUserHandler(db database.Database, bus *users.Bus, log logger.Logger) *DeleteUserHandler {
+	return &DeleteUserHandler{db: db, bus: bus, logger: log}
+}
+
+func (h *DeleteUserHandler) Handle(ctx context.Context, cmd DeleteUser) (DeleteUserResult, error) {
+	user, err := h.db.GetUserByID(ctx, cmd.ID)
+	if err != nil {
+		return DeleteUserResult{}, fmt.Errorf("failed to load user: %w", err)
+	}
+	if user == nil {
+		return DeleteUserResult{}, fmt.Errorf("user with ID %d not found", cmd.ID)
+	}
+
+	if err := h.db.DeleteUser(ctx, cmd.ID); err != nil {
+		return DeleteUserResult{}, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	h.logger.Info("user deleted", "user_id", cmd.ID)
+	h.bus.Publish(ctx, users.UserDeleted{UserID: cmd.ID, Email: user.Email})
+
+	return DeleteUserResult{}, nil
+}
+
+// BulkUpdateUsersHandler applies many updates in one transaction and
+// publishes one users.UserUpdated event per row.
+type BulkUpdateUsersHandler struct {
+	db     database.Database
+	bus    *users.Bus
+	logger logger.Logger
+}
+
+func NewBulkUpdateUsersHandler(db database.Database, bus *users.Bus, log logger.Logger) *BulkUpdateUsersHandler {
+	return &BulkUpdateUsersHandler{db: db, bus: bus, logger: log}
+}
+
+func (h *BulkUpdateUsersHandler) Handle(ctx context.Context, cmd BulkUpdateUsers) (BulkUpdateUsersResult, error) {
+	results, err := h.db.BulkUpdateUsers(ctx, cmd.Updates)
+	if err != nil {
+		return BulkUpdateUsersResult{}, fmt.Errorf("bulk update failed: %w", err)
+	}
+
+	for _, result := range results {
+		h.bus.Publish(ctx, users.UserUpdated{Old: result.Old, New: result.New})
+	}
+
+	h.logger.Info("bulk user updates completed", "count", len(results))
+	return BulkUpdateUsersResult{}, nil
+}
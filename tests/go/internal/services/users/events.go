@@ -0,0 +1,22 @@
+package users
+
+import "github.com/crawler/test-go/internal/models"
+
+// UserCreated is published after a user is successfully created.
+type UserCreated struct {
+	User *models.User
+}
+
+// UserUpdated is published after a user is successfully updated, carrying
+// both the pre- and post-update state so subscribers can diff them (e.g. to
+// invalidate a changed email's cache key).
+type UserUpdated struct {
+	Old *models.User
+	New *models.User
+}
+
+// UserDeleted is published after a user is soft deleted.
+type UserDeleted struct {
+	UserID uint64
+	Email  string
+}
@@ -0,0 +1,62 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Validatable is implemented by commands/queries that can check their own
+// well-formedness before a Handler runs.
+type Validatable interface {
+	Validate() error
+}
+
+// LoggingMiddleware logs every dispatch with its type and duration.
+func LoggingMiddleware(log logger.Logger) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg any) (any, error) {
+			start := time.Now()
+			result, err := next(ctx, msg)
+			log.Debug("bus dispatch", "type", fmt.Sprintf("%T", msg), "duration", time.Since(start), "error", err)
+			return result, err
+		}
+	}
+}
+
+// ValidationMiddleware rejects a message before it reaches its Handler if
+// the message implements Validatable and fails validation.
+func ValidationMiddleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg any) (any, error) {
+			if v, ok := msg.(Validatable); ok {
+				if err := v.Validate(); err != nil {
+					return nil, fmt.Errorf("validation failed: %w", err)
+				}
+			}
+			return next(ctx, msg)
+		}
+	}
+}
+
+type traceIDKey struct{}
+
+// TracingMiddleware attaches a per-dispatch trace ID to the context so
+// handlers and subscribers downstream can correlate log lines.
+func TracingMiddleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg any) (any, error) {
+			ctx = context.WithValue(ctx, traceIDKey{}, uuid.NewString())
+			return next(ctx, msg)
+		}
+	}
+}
+
+// TraceID returns the trace ID attached by TracingMiddleware, if any.
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey{}).(string)
+	return id
+}
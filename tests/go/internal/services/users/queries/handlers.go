@@ -0,0 +1,153 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/database"
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/internal/services/users"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+const cacheTTL = 5 * time.Minute
+
+// errNotFound lets GetOrLoad's loader signal "no such user" without that
+// absence itself being cached as a value.
+var errNotFound = errors.New("user not found")
+
+// Cache is the narrow slice of a key-value cache the read handlers in this
+// package need; any services.CacheService implementation satisfies it.
+type Cache interface {
+	GetOrLoad(ctx context.Context, key string, dest interface{}, ttl time.Duration, loader func() (interface{}, error)) error
+}
+
+// GetByIDHandler reads a user by ID, checking the cache first.
+type GetByIDHandler struct {
+	db     database.Database
+	cache  Cache
+	logger logger.Logger
+}
+
+func NewGetByIDHandler(db database.Database, cache Cache, log logger.Logger) *GetByIDHandler {
+	return &GetByIDHandler{db: db, cache: cache, logger: log}
+}
+
+func (h *GetByIDHandler) Handle(ctx context.Context, q GetByID) (GetByIDResult, error) {
+	var user models.User
+	err := h.cache.GetOrLoad(ctx, users.IDCacheKey(q.ID), &user, cacheTTL, func() (interface{}, error) {
+		found, err := h.db.GetUserByID(ctx, q.ID)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			return nil, errNotFound
+		}
+		return found, nil
+	})
+	if errors.Is(err, errNotFound) {
+		return GetByIDResult{}, nil
+	}
+	if err != nil {
+		return GetByIDResult{}, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+
+	return GetByIDResult{User: &user}, nil
+}
+
+// GetByEmailHandler reads a user by email, checking the cache first.
+type GetByEmailHandler struct {
+	db     database.Database
+	cache  Cache
+	logger logger.Logger
+}
+
+func NewGetByEmailHandler(db database.Database, cache Cache, log logger.Logger) *GetByEmailHandler {
+	return &GetByEmailHandler{db: db, cache: cache, logger: log}
+}
+
+func (h *GetByEmailHandler) Handle(ctx context.Context, q GetByEmail) (GetByEmailResult, error) {
+	var user models.User
+	err := h.cache.GetOrLoad(ctx, users.EmailCacheKey(q.Email), &user, cacheTTL, func() (interface{}, error) {
+		found, err := h.db.GetUserByEmail(ctx, q.Email)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			return nil, errNotFound
+		}
+		return found, nil
+	})
+	if errors.Is(err, errNotFound) {
+		return GetByEmailResult{}, nil
+	}
+	if err != nil {
+		return GetByEmailResult{}, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	return GetByEmailResult{User: &user}, nil
+}
+
+// listCacheTTL is shorter than cacheTTL: list pages churn faster than a
+// single user record, and no subscriber evicts them on writes, so a cached
+// page can only go stale until this TTL expires.
+const listCacheTTL = 30 * time.Second
+
+// ListHandler reads users matching filters, checking the cache first. Each
+// distinct filter+cursor combination caches under its own key (see
+// users.ListCacheKey) so pages never collide.
+type ListHandler struct {
+	db    database.Database
+	cache Cache
+}
+
+func NewListHandler(db database.Database, cache Cache) *ListHandler {
+	return &ListHandler{db: db, cache: cache}
+}
+
+func (h *ListHandler) Handle(ctx context.Context, q List) (ListResult, error) {
+	filters := q.Filters
+	if filters == nil {
+		filters = &models.UserFilters{}
+	}
+
+	var page models.PageResult[*models.User]
+	err := h.cache.GetOrLoad(ctx, users.ListCacheKey(filters), &page, listCacheTTL, func() (interface{}, error) {
+		return h.db.GetUsers(ctx, filters)
+	})
+	if err != nil {
+		return ListResult{}, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	return ListResult{Page: &page}, nil
+}
+
+// statsCacheTTL outlives StatsSnapshotJob's weekly cadence, so a missed job
+// run still serves the last snapshot instead of falling back to four live
+// COUNT queries on every admin request.
+const statsCacheTTL = 8 * 24 * time.Hour
+
+// StatsHandler reads aggregate user statistics, checking the cached
+// snapshot StatsSnapshotJob maintains first.
+type StatsHandler struct {
+	db    database.Database
+	cache Cache
+}
+
+func NewStatsHandler(db database.Database, cache Cache) *StatsHandler {
+	return &StatsHandler{db: db, cache: cache}
+}
+
+func (h *StatsHandler) Handle(ctx context.Context, q Stats) (StatsResult, error) {
+	var stats models.UserStats
+	err := h.cache.GetOrLoad(ctx, users.StatsCacheKey(), &stats, statsCacheTTL, func() (interface{}, error) {
+		return h.db.GetUserStats(ctx)
+	})
+	if err != nil {
+		return StatsResult{}, fmt.Errorf("failed to get user stats: %w", err)
+	}
+
+	return StatsResult{Stats: &stats}, nil
+}
@@ -0,0 +1,43 @@
+// Package queries holds the read-side messages of the user CQRS split, each
+// paired with the Result its Handler returns.
+package queries
+
+import "github.com/crawler/test-go/internal/models"
+
+// GetByID looks up a single user by ID.
+type GetByID struct {
+	ID uint64
+}
+
+// GetByIDResult is returned by GetByID's Handler.
+type GetByIDResult struct {
+	User *models.User
+}
+
+// GetByEmail looks up a single user by email.
+type GetByEmail struct {
+	Email string
+}
+
+// GetByEmailResult is returned by GetByEmail's Handler.
+type GetByEmailResult struct {
+	User *models.User
+}
+
+// List returns users matching the given filters.
+type List struct {
+	Filters *models.UserFilters
+}
+
+// ListResult is returned by List's Handler.
+type ListResult struct {
+	Page *models.PageResult[*models.User]
+}
+
+// Stats returns aggregate user statistics.
+type Stats struct{}
+
+// StatsResult is returned by Stats's Handler.
+type StatsResult struct {
+	Stats *models.UserStats
+}
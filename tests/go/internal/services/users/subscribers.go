@@ -0,0 +1,77 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crawler/test-go/internal/models"
+	"github.com/crawler/test-go/pkg/logger"
+)
+
+// Cache is the narrow slice of a key-value cache the subscribers in this
+// file need; any services.CacheService implementation satisfies it.
+type Cache interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// AuditStore is the narrow slice of database.Database the audit subscriber
+// needs.
+type AuditStore interface {
+	CreateAuditLog(ctx context.Context, entry *models.AuditLog) error
+}
+
+// RegisterCacheInvalidationSubscriber subscribes to the user domain events
+// and removes the ID/email cache entries the replaced ad-hoc
+// invalidateUserCache calls used to maintain by hand.
+func RegisterCacheInvalidationSubscriber(bus *Bus, cache Cache, log logger.Logger) {
+	Subscribe(bus, func(ctx context.Context, event UserUpdated) error {
+		invalidate(ctx, cache, log, IDCacheKey(event.Old.ID))
+		invalidate(ctx, cache, log, EmailCacheKey(event.Old.Email))
+		if event.New.Email != event.Old.Email {
+			invalidate(ctx, cache, log, EmailCacheKey(event.New.Email))
+		}
+		return nil
+	})
+
+	Subscribe(bus, func(ctx context.Context, event UserDeleted) error {
+		invalidate(ctx, cache, log, IDCacheKey(event.UserID))
+		invalidate(ctx, cache, log, EmailCacheKey(event.Email))
+		return nil
+	})
+}
+
+func invalidate(ctx context.Context, cache Cache, log logger.Logger, key string) {
+	if err := cache.Delete(ctx, key); err != nil {
+		log.Warn("failed to invalidate cache key", "key", key, "error", err)
+	}
+}
+
+// RegisterAuditLogSubscriber subscribes to the user domain events and
+// appends one audit row per mutation.
+func RegisterAuditLogSubscriber(bus *Bus, store AuditStore, log logger.Logger) {
+	Subscribe(bus, func(ctx context.Context, event UserCreated) error {
+		return writeAudit(ctx, store, log, event.User.ID, "user.created", fmt.Sprintf("email=%s", event.User.Email))
+	})
+
+	Subscribe(bus, func(ctx context.Context, event UserUpdated) error {
+		return writeAudit(ctx, store, log, event.New.ID, "user.updated", fmt.Sprintf("email=%s", event.New.Email))
+	})
+
+	Subscribe(bus, func(ctx context.Context, event UserDeleted) error {
+		return writeAudit(ctx, store, log, event.UserID, "user.deleted", fmt.Sprintf("email=%s", event.Email))
+	})
+}
+
+func writeAudit(ctx context.Context, store AuditStore, log logger.Logger, userID uint64, action, detail string) error {
+	err := store.CreateAuditLog(ctx, &models.AuditLog{
+		UserID:    userID,
+		Action:    action,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Warn("failed to write audit log", "action", action, "user_id", userID, "error", err)
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+package users
+
+import (
+	"fmt"
+	"net/mail"
+
+	"github.com/crawler/test-go/internal/models"
+)
+
+// Validator checks a models.User for well-formedness before it is persisted.
+type Validator interface {
+	ValidateEmail(email string) error
+	ValidatePassword(password string) error
+	ValidateRole(role models.UserRole) error
+	ValidateUserData(user *models.User) []string
+}
+
+type validator struct{}
+
+// NewValidator creates the default Validator implementation
+func NewValidator() Validator {
+	return &validator{}
+}
+
+func (v *validator) ValidateEmail(email string) error {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("invalid email address: %w", err)
+	}
+	return nil
+}
+
+func (v *validator) ValidatePassword(password string) error {
+	if len(password) < 8 {
+		return fmt.Errorf("password must be at least 8 characters")
+	}
+	return nil
+}
+
+func (v *validator) ValidateRole(role models.UserRole) error {
+	switch role {
+	case models.RoleAdmin, models.RoleUser:
+		return nil
+	default:
+		return fmt.Errorf("invalid role: %s", role)
+	}
+}
+
+func (v *validator) ValidateUserData(user *models.User) []string {
+	var errs []string
+
+	if err := v.ValidateEmail(user.Email); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if user.FirstName == "" {
+		errs = append(errs, "first name is required")
+	}
+	if user.LastName == "" {
+		errs = append(errs, "last name is required")
+	}
+	if err := v.ValidateRole(user.Role); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	return errs
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+// Logger is the structured logging interface used throughout the application
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// Level controls which severities are emitted
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+type logger struct {
+	level Level
+	out   *log.Logger
+}
+
+// New creates a Logger that writes to stdout, filtering by the given level
+// ("debug", "info", "warn", "error"; defaults to "info" on an unknown value).
+func New(level string) Logger {
+	return &logger{
+		level: parseLevel(level),
+		out:   log.New(os.Stdout, "", log.LstdFlags),
+	}
+}
+
+func parseLevel(level string) Level {
+	switch level {
+	case "debug":
+		return LevelDebug
+	case "warn":
+		return LevelWarn
+	case "error":
+		return LevelError
+	default:
+		return LevelInfo
+	}
+}
+
+func (l *logger) Debug(msg string, keyvals ...interface{}) { l.log(LevelDebug, "DEBUG", msg, keyvals) }
+func (l *logger) Info(msg string, keyvals ...interface{})  { l.log(LevelInfo, "INFO", msg, keyvals) }
+func (l *logger) Warn(msg string, keyvals ...interface{})  { l.log(LevelWarn, "WARN", msg, keyvals) }
+func (l *logger) Error(msg string, keyvals ...interface{}) { l.log(LevelError, "ERROR", msg, keyvals) }
+
+func (l *logger) log(level Level, tag, msg string, keyvals []interface{}) {
+	if level < l.level {
+		return
+	}
+	l.out.Println(format(tag, msg, keyvals))
+}
+
+func format(tag, msg string, keyvals []interface{}) string {
+	line := fmt.Sprintf("[%s] %s", tag, msg)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		line += fmt.Sprintf(" %v=%v", keyvals[i], keyvals[i+1])
+	}
+	return line
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crawler/test-go/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CORS allows cross-origin requests from any origin
+func CORS() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Access-Control-Allow-Origin", "*")
+		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		c.Header("Access-Control-Allow-Headers", "Authorization, Content-Type")
+
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequestID attaches a unique ID to every request for log correlation
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader("X-Request-ID")
+		if id == "" {
+			id = uuid.NewString()
+		}
+		c.Set("request_id", id)
+		c.Header("X-Request-ID", id)
+		c.Next()
+	}
+}
+
+// RateLimit caps each client IP to limitPerMinute requests per fixed
+// one-minute window, which starts at the IP's first request after the
+// previous window expired
+func RateLimit(limitPerMinute int) gin.HandlerFunc {
+	type bucket struct {
+		count   int
+		resetAt time.Time
+	}
+
+	var mu sync.Mutex
+	buckets := make(map[string]*bucket)
+
+	return func(c *gin.Context) {
+		key := c.ClientIP()
+		now := time.Now()
+
+		mu.Lock()
+		b, ok := buckets[key]
+		if !ok || now.After(b.resetAt) {
+			b = &bucket{count: 0, resetAt: now.Add(time.Minute)}
+			buckets[key] = b
+		}
+		b.count++
+		exceeded := b.count > limitPerMinute
+		mu.Unlock()
+
+		if exceeded {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AuthMiddleware validates the bearer token and attaches the authenticated user ID to the context
+func AuthMiddleware(authSvc services.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" || !strings.HasPrefix(header, "Bearer ") {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing authorization token"})
+			return
+		}
+		token := strings.TrimPrefix(header, "Bearer ")
+
+		claims, err := authSvc.ValidateToken(token)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+			return
+		}
+
+		c.Set("user_id", claims.UserID)
+		c.Set("user_role", claims.Role)
+		c.Next()
+	}
+}
+
+// RequireRole restricts a route group to callers holding the given role
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole, _ := c.Get("user_role")
+		if userRole != role {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+			return
+		}
+		c.Next()
+	}
+}